@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ruziba3vich/chess_app/internal/game_service"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"github.com/ruziba3vich/chess_app/internal/service"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"github.com/ruziba3vich/chess_app/pkg/config"
+	"github.com/ruziba3vich/chess_app/pkg/logger"
+	"google.golang.org/grpc"
+)
+
+// shutdownTimeout is how long each step of the shutdown may take, open streams are cut once it passes
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalln("failed to load config:", err)
+	}
+
+	appLogger, err := logger.NewLogger(cfg.LogFile)
+	if err != nil {
+		log.Fatalln("failed to create logger:", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	db, err := storage.ConnectDB(cfg, ctx)
+	if err != nil {
+		appLogger.Fatalln(err)
+	}
+	if err := db.CheckTransactions(ctx); err != nil {
+		appLogger.Fatalln(err)
+	}
+	if err := db.CreateIndexes(ctx); err != nil {
+		appLogger.Fatalln(err)
+	}
+
+	redisStorage := redisservice.NewRedisStorage(redisservice.NewPool(cfg.RedisURI), cfg.GameConfig.RedisChannel)
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.RedisURI})
+	if err := redisClient.Ping(ctx).Err(); err != nil {
+		appLogger.Fatalln("failed to ping redis:", err)
+	}
+
+	luaScript, err := os.ReadFile(cfg.GameConfig.LuaScriptPath)
+	if err != nil {
+		appLogger.Fatalln("failed to read lua script:", err)
+	}
+
+	gameStorage := storage.NewStorage(db, appLogger, redisStorage, cfg)
+	var wg sync.WaitGroup
+	matchmaking := game_service.NewMatchmakingService(
+		redisClient,
+		make(map[string]chan *genprotos.MatchEvent),
+		cfg,
+		gameStorage,
+		&wg,
+		appLogger,
+		string(luaScript),
+	)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		matchmaking.RunDelivery(workersCtx)
+	}()
+	for _, queue := range matchmaking.Queues() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			matchmaking.MatchPlayers(workersCtx, queue)
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		gameStorage.RunClockSweeper(workersCtx, cfg.GameConfig.SweepInterval)
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		gameStorage.RunPresenceSweeper(workersCtx, cfg.GameConfig.SweepInterval)
+	}()
+
+	listener, err := net.Listen(cfg.Protocol, ":"+cfg.Port)
+	if err != nil {
+		appLogger.Fatalln("failed to listen:", err)
+	}
+
+	server := grpc.NewServer()
+	genprotos.RegisterGameServiceServer(server, service.NewGameService(gameStorage, matchmaking))
+
+	go func() {
+		appLogger.Printf("server is listening on %s:%s", cfg.Protocol, cfg.Port)
+		if err := server.Serve(listener); err != nil {
+			appLogger.Println("server stopped:", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	appLogger.Println("shutting down")
+
+	// PlayGame, WatchGame and WatchChallenges streams stay open for as long as their games,
+	// GracefulStop would wait for all of them
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		appLogger.Println("closing the streams still open")
+		server.Stop()
+	}
+	stopWorkers()
+	wg.Wait()
+
+	if err := redisClient.Close(); err != nil {
+		appLogger.Println("failed to close redis client:", err)
+	}
+	if err := redisStorage.Close(); err != nil {
+		appLogger.Println("failed to close redis pool:", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := db.DisconnectDB(shutdownCtx); err != nil {
+		appLogger.Println(err)
+	}
+}
@@ -0,0 +1,66 @@
+package clock
+
+import (
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// Running reports whether the clock of the side to move is running.
+// Clocks start once both players have made their first move
+func Running(live *models.LiveGame) bool {
+	return live.Status == models.StatusOngoing && len(live.Game.Moves()) >= 2
+}
+
+// Remaining returns the time the player of the given colour has left at now
+func Remaining(live *models.LiveGame, color chess.Color, now time.Time) time.Duration {
+	remaining := live.WhiteClock
+	if color == chess.Black {
+		remaining = live.BlackClock
+	}
+	if Running(live) && live.Game.Position().Turn() == color {
+		if elapsed := now.Sub(live.TurnStarted) - live.Delay; elapsed > 0 {
+			remaining -= elapsed
+		}
+	}
+	return max(remaining, 0)
+}
+
+// Deadline returns when the side to move runs out of time, it reports false if no clock is running
+func Deadline(live *models.LiveGame) (time.Time, bool) {
+	if !Running(live) {
+		return time.Time{}, false
+	}
+	remaining := live.WhiteClock
+	if live.Game.Position().Turn() == chess.Black {
+		remaining = live.BlackClock
+	}
+	return live.TurnStarted.Add(live.Delay + remaining), true
+}
+
+// Punch stops the clock of the player who is about to move at now: the time spent
+// on the move is deducted and the increment is added. It has to be called before the move is played
+func Punch(live *models.LiveGame, color chess.Color, now time.Time) {
+	if Running(live) {
+		set(live, color, Remaining(live, color, now)+live.Increment)
+	}
+	live.TurnStarted = now
+}
+
+// Stop freezes the clocks of a game that is ending at now without a move
+func Stop(live *models.LiveGame, now time.Time) {
+	if Running(live) {
+		turn := live.Game.Position().Turn()
+		set(live, turn, Remaining(live, turn, now))
+	}
+	live.TurnStarted = now
+}
+
+func set(live *models.LiveGame, color chess.Color, remaining time.Duration) {
+	if color == chess.Black {
+		live.BlackClock = remaining
+	} else {
+		live.WhiteClock = remaining
+	}
+}
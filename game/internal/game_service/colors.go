@@ -0,0 +1,142 @@
+package game_service
+
+import (
+	"context"
+	"math/rand/v2"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+)
+
+// colorHistorySize is how many of a player's recent colours are kept for balancing
+const colorHistorySize = 20
+
+// ColorRequest is what the colour assignment knows about a matched player
+type ColorRequest struct {
+	History   []genprotos.Color // colours of the player's recent games, the most recent first
+	Preferred genprotos.Color   // NO_COLOR if the player does not mind
+}
+
+// FirstPlaysWhite decides whether the first of two matched players gets white. Preferences are followed
+// unless they conflict, otherwise the player who had white less often recently gets it, then the one
+// who had black last. A tie is broken by the coin flip
+func FirstPlaysWhite(first, second ColorRequest, coin bool) bool {
+	switch {
+	case first.Preferred == genprotos.Color_WHITE && second.Preferred != genprotos.Color_WHITE,
+		second.Preferred == genprotos.Color_BLACK && first.Preferred != genprotos.Color_BLACK:
+		return true
+	case first.Preferred == genprotos.Color_BLACK && second.Preferred != genprotos.Color_BLACK,
+		second.Preferred == genprotos.Color_WHITE && first.Preferred != genprotos.Color_WHITE:
+		return false
+	}
+
+	if firstBalance, secondBalance := colorBalance(first.History), colorBalance(second.History); firstBalance != secondBalance {
+		return firstBalance < secondBalance
+	}
+	if firstLast, secondLast := lastColor(first.History), lastColor(second.History); firstLast != secondLast {
+		return firstLast == genprotos.Color_BLACK || secondLast == genprotos.Color_WHITE
+	}
+	return coin
+}
+
+// colorBalance returns how many more games the player had white than black
+func colorBalance(history []genprotos.Color) int {
+	balance := 0
+	for _, color := range history {
+		switch color {
+		case genprotos.Color_WHITE:
+			balance++
+		case genprotos.Color_BLACK:
+			balance--
+		}
+	}
+	return balance
+}
+
+func lastColor(history []genprotos.Color) genprotos.Color {
+	if len(history) == 0 {
+		return genprotos.Color_NO_COLOR
+	}
+	return history[0]
+}
+
+func colorHistoryKey(playerID string) string {
+	return "matchmaking:color_history:" + playerID
+}
+
+// preferredColorKey returns the hash that keeps the colour each player of the queue asked for
+func preferredColorKey(queueKey string) string {
+	return queueKey + ":preferred_color"
+}
+
+// assignColors returns the matched players as white and black
+func (m *MatchmakingService) assignColors(ctx context.Context, queue Queue, player1, player2 string) (string, string, error) {
+	first, err := m.colorRequest(ctx, queue, player1)
+	if err != nil {
+		return "", "", err
+	}
+	second, err := m.colorRequest(ctx, queue, player2)
+	if err != nil {
+		return "", "", err
+	}
+
+	if FirstPlaysWhite(first, second, rand.IntN(2) == 0) {
+		return player1, player2, nil
+	}
+	return player2, player1, nil
+}
+
+func (m *MatchmakingService) colorRequest(ctx context.Context, queue Queue, playerID string) (ColorRequest, error) {
+	values, err := m.redisClient.LRange(ctx, colorHistoryKey(playerID), 0, colorHistorySize-1).Result()
+	if err != nil {
+		return ColorRequest{}, err
+	}
+	request := ColorRequest{History: make([]genprotos.Color, len(values))}
+	for i, value := range values {
+		request.History[i] = genprotos.Color(genprotos.Color_value[value])
+	}
+
+	if !queue.Casual {
+		return request, nil
+	}
+	preferred, err := m.redisClient.HGet(ctx, preferredColorKey(m.queueKey(queue)), playerID).Result()
+	if err != nil && err != redis.Nil {
+		return ColorRequest{}, err
+	}
+	request.Preferred = genprotos.Color(genprotos.Color_value[preferred])
+	return request, nil
+}
+
+// recordColors adds the colours of a new game to the players' histories and forgets their preferences
+func (m *MatchmakingService) recordColors(ctx context.Context, queue Queue, white, black string) error {
+	_, err := m.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pushColors(ctx, pipe, white, black)
+		pipe.HDel(ctx, preferredColorKey(m.queueKey(queue)), white, black)
+		return nil
+	})
+	return err
+}
+
+// RecordGameColors adds the colours of a game created outside of matchmaking, e.g. from a challenge,
+// to the players' histories so that later matches balance them as well. The game exists already,
+// a failure is only logged since it only makes the next assignment less balanced
+func (m *MatchmakingService) RecordGameColors(ctx context.Context, gameID string) {
+	players, err := m.storage.GetPlayers(ctx, gameID)
+	if err == nil {
+		_, err = m.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pushColors(ctx, pipe, players[0], players[1])
+			return nil
+		})
+	}
+	if err != nil {
+		m.logger.Println("Error recording colours:", err)
+	}
+}
+
+func pushColors(ctx context.Context, pipe redis.Pipeliner, white, black string) {
+	for player, color := range map[string]genprotos.Color{white: genprotos.Color_WHITE, black: genprotos.Color_BLACK} {
+		key := colorHistoryKey(player)
+		pipe.LPush(ctx, key, color.String())
+		pipe.LTrim(ctx, key, 0, colorHistorySize-1)
+	}
+}
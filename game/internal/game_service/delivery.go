@@ -0,0 +1,222 @@
+package game_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+)
+
+// Matches are delivered through Redis so that any replica can pair players searching on any other one.
+// A worker that pairs two players offers the match on their channels, the replicas holding the players'
+// searches claim it and only a match both players claimed becomes a game. If a claim does not arrive in
+// time both players are put back into the queue, provided they are still searching.
+
+// matchNotice is published on a player's match channel, an offer carries only the match id
+type matchNotice struct {
+	Cancelled  bool            `json:"cancelled,omitempty"`
+	MatchID    string          `json:"match_id,omitempty"`
+	GameID     string          `json:"game_id,omitempty"`
+	Color      genprotos.Color `json:"color,omitempty"`
+	OpponentID string          `json:"opponent_id,omitempty"`
+}
+
+// pairedPlayer is a player the matchmaking script took out of the queue
+type pairedPlayer struct {
+	ID       string
+	Score    string // as stored in the queue
+	JoinedAt string // unix milliseconds
+}
+
+// requeueScript puts the players of a failed match back into the queue if they are still searching
+//
+// KEYS[1] queue, KEYS[2] hash of join times, KEYS[3] and KEYS[4] search markers of the players
+// ARGV player, score and join time of the first and then of the second player
+var requeueScript = redis.NewScript(`
+local requeued = 0
+for i = 0, 1 do
+	if redis.call('EXISTS', KEYS[3 + i]) == 1 then
+		redis.call('ZADD', KEYS[1], ARGV[3 * i + 2], ARGV[3 * i + 1])
+		redis.call('HSET', KEYS[2], ARGV[3 * i + 1], ARGV[3 * i + 3])
+		requeued = requeued + 1
+	end
+end
+return requeued
+`)
+
+// takeSearchesScript ends the searches of both matched players, but only if both are still searching
+//
+// KEYS[1] and KEYS[2] search markers of the players
+var takeSearchesScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1], KEYS[2]) < 2 then return 0 end
+redis.call('DEL', KEYS[1], KEYS[2])
+return 1
+`)
+
+// cancelScript removes the player from every queue and ends its search
+//
+// KEYS[1] search marker of the player, then the queue, join time and preferred colour keys of every queue
+// ARGV[1] player
+var cancelScript = redis.NewScript(`
+local removed = redis.call('DEL', KEYS[1])
+for i = 2, #KEYS, 3 do
+	removed = removed + redis.call('ZREM', KEYS[i], ARGV[1])
+	redis.call('HDEL', KEYS[i + 1], ARGV[1])
+	redis.call('HDEL', KEYS[i + 2], ARGV[1])
+end
+return removed
+`)
+
+func matchChannel(playerID string) string {
+	return "match:" + playerID
+}
+
+// searchingKey marks a player whose search is open on some replica
+func searchingKey(playerID string) string {
+	return "matchmaking:searching:" + playerID
+}
+
+func claimsKey(matchID string) string {
+	return "matchmaking:match:" + matchID + ":claims"
+}
+
+// RunDelivery hands the match notices published for the players searching on this replica to their searches
+func (m *MatchmakingService) RunDelivery(ctx context.Context) {
+	notices := m.pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := m.pubsub.Close(); err != nil {
+				m.logger.Println("Error closing match subscription:", err)
+			}
+			return
+		case msg, ok := <-notices:
+			if !ok {
+				return
+			}
+			m.deliver(ctx, msg)
+		}
+	}
+}
+
+func (m *MatchmakingService) deliver(ctx context.Context, msg *redis.Message) {
+	var notice matchNotice
+	if err := json.Unmarshal([]byte(msg.Payload), &notice); err != nil {
+		m.logger.Println("Error decoding match notice:", err)
+		return
+	}
+	playerID := msg.Channel[len(matchChannel("")):]
+
+	m.mutex.Lock()
+	_, searching := m.playerChannels[playerID]
+	m.mutex.Unlock()
+	if !searching {
+		return
+	}
+
+	if notice.Cancelled {
+		m.notifyPlayer(playerID, &genprotos.MatchEvent{
+			Event: &genprotos.MatchEvent_Cancelled{Cancelled: &genprotos.Cancelled{}},
+		})
+		return
+	}
+	if notice.GameID == "" {
+		if err := m.claim(ctx, notice.MatchID, playerID); err != nil {
+			m.logger.Println("Error claiming match:", err)
+		}
+		return
+	}
+	m.notifyPlayer(playerID, matchedEvent(notice.GameID, notice.Color, notice.OpponentID))
+}
+
+// claim tells the worker that offered the match that the player's search is alive
+func (m *MatchmakingService) claim(ctx context.Context, matchID, playerID string) error {
+	key := claimsKey(matchID)
+	_, err := m.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, key, playerID)
+		pipe.PExpire(ctx, key, m.config.GameConfig.ClaimTimeout)
+		return nil
+	})
+	return err
+}
+
+// offerMatch publishes the match to both players and reports whether both claimed it before the claim timeout
+func (m *MatchmakingService) offerMatch(ctx context.Context, player1, player2 string) (bool, error) {
+	matchID := strconv.FormatUint(rand.Uint64(), 36)
+	key := claimsKey(matchID)
+	defer m.redisClient.Del(context.Background(), key)
+
+	for _, player := range []string{player1, player2} {
+		if err := m.publish(ctx, player, matchNotice{MatchID: matchID}); err != nil {
+			return false, err
+		}
+	}
+
+	deadline := time.Now().Add(m.config.GameConfig.ClaimTimeout)
+	claimed := make(map[string]bool, 2)
+	for len(claimed) < 2 {
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return false, nil
+		}
+		// Redis blocks for whole seconds at least, zero would block forever
+		res, err := m.redisClient.BLPop(ctx, max(wait, time.Second), key).Result()
+		if err == redis.Nil {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		claimed[res[1]] = true
+	}
+	return true, nil
+}
+
+// takeSearches ends the searches of both players of a claimed match, it reports false if one of them
+// stopped searching in the meantime
+func (m *MatchmakingService) takeSearches(ctx context.Context, player1, player2 string) (bool, error) {
+	taken, err := takeSearchesScript.Run(ctx, m.redisClient, []string{searchingKey(player1), searchingKey(player2)}).Int()
+	return taken == 1, err
+}
+
+// requeue puts the players of a match that could not be completed back into the queue with their
+// original score and join time, players who stopped searching in the meantime are left out
+func (m *MatchmakingService) requeue(ctx context.Context, queue Queue, players [2]pairedPlayer) error {
+	queueKey := m.queueKey(queue)
+	keys := []string{queueKey, enqueuedAtKey(queueKey), searchingKey(players[0].ID), searchingKey(players[1].ID)}
+	args := make([]interface{}, 0, 6)
+	for _, player := range players {
+		args = append(args, player.ID, player.Score, player.JoinedAt)
+	}
+
+	requeued, err := requeueScript.Run(ctx, m.redisClient, keys, args...).Int()
+	if err != nil {
+		return err
+	}
+	m.logger.Printf("match of %s and %s could not be completed, %d player(s) put back into the queue",
+		players[0].ID, players[1].ID, requeued)
+	return nil
+}
+
+// cancelMatch tells both players of a match whose game could not be created that their search is over,
+// their searches were ended when the match was claimed so they can not be put back into the queue
+func (m *MatchmakingService) cancelMatch(players [2]pairedPlayer) {
+	for _, player := range players {
+		if err := m.publish(context.Background(), player.ID, matchNotice{Cancelled: true}); err != nil {
+			m.logger.Println("Error cancelling match:", err)
+		}
+	}
+}
+
+func (m *MatchmakingService) publish(ctx context.Context, playerID string, notice matchNotice) error {
+	payload, err := json.Marshal(notice)
+	if err != nil {
+		return fmt.Errorf("could not encode match notice: %w", err)
+	}
+	return m.redisClient.Publish(ctx, matchChannel(playerID), payload).Err()
+}
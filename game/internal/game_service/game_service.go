@@ -2,19 +2,38 @@ package game_service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
 	"github.com/ruziba3vich/chess_app/internal/storage"
 	"github.com/ruziba3vich/chess_app/pkg/config"
 )
 
+// progressInterval is how often a searching player receives queue updates
+const progressInterval = 2 * time.Second
+
+var (
+	// ErrAlreadySearching is returned when a player who is already in a queue tries to join one
+	ErrAlreadySearching = errors.New("player is already searching for a game")
+	// ErrSearchCancelled is returned to a search the player cancelled
+	ErrSearchCancelled = errors.New("search was cancelled")
+)
+
+// Queue identifies a matchmaking queue, there is a rated and a casual one for every duration
+type Queue struct {
+	Duration int32 // minutes
+	Casual   bool
+}
+
 type MatchmakingService struct {
 	redisClient    *redis.Client
-	playerChannels map[string]chan string
+	playerChannels map[string]chan *genprotos.MatchEvent // searches open on this replica
+	pubsub         *redis.PubSub                         // match channels of the players searching on this replica
 	mutex          sync.Mutex
 	wg             *sync.WaitGroup
 	config         *config.Config
@@ -25,7 +44,7 @@ type MatchmakingService struct {
 
 func NewMatchmakingService(
 	redisClient *redis.Client,
-	playerChannels map[string]chan string,
+	playerChannels map[string]chan *genprotos.MatchEvent,
 	config *config.Config,
 	storage *storage.Storage,
 	wg *sync.WaitGroup,
@@ -35,6 +54,7 @@ func NewMatchmakingService(
 	return &MatchmakingService{
 		redisClient:    redisClient,
 		playerChannels: playerChannels,
+		pubsub:         redisClient.Subscribe(context.Background()),
 		config:         config,
 		storage:        storage,
 		wg:             wg,
@@ -43,33 +63,250 @@ func NewMatchmakingService(
 	}
 }
 
-func (m *MatchmakingService) AddPlayer(ctx context.Context, playerID string, score float64, duration int32, playerChannel chan string) error {
+// AddPlayer puts the player into the queue with the given score, preferred is the colour
+// the player asks for and is only taken into account in casual queues
+func (m *MatchmakingService) AddPlayer(
+	ctx context.Context,
+	playerID string,
+	score float64,
+	queue Queue,
+	preferred genprotos.Color,
+	playerChannel chan *genprotos.MatchEvent,
+) error {
+	// the search marker keeps a player out of every other queue, whichever replica it searches on
+	cfg := m.config.GameConfig
+	searchFor := time.Duration(cfg.SearchDuration)*time.Minute + cfg.ClaimTimeout
+	queueKey := m.queueKey(queue)
+	marked, err := m.redisClient.SetNX(ctx, searchingKey(playerID), queueKey, searchFor).Result()
+	if err != nil {
+		m.logger.Println("Error marking player as searching:", err)
+		return err
+	}
+	if !marked {
+		return ErrAlreadySearching
+	}
+
 	m.mutex.Lock()
 	m.playerChannels[playerID] = playerChannel
 	m.mutex.Unlock()
 
-	// Redis key based on duration (e.g., "score_queue_10min")
-	queueKey := fmt.Sprintf("%s_%dmin", m.config.GameConfig.ScoreQueue, duration)
+	// the match can be found by a worker of any replica, so the player listens before joining the queue
+	if err := m.pubsub.Subscribe(ctx, matchChannel(playerID)); err != nil {
+		m.logger.Println("Error subscribing to match channel:", err)
+		m.removeAfterSearch(playerID, queue)
+		return err
+	}
 
-	err := m.redisClient.ZAdd(ctx, queueKey, redis.Z{
-		Score:  score,
-		Member: playerID,
-	}).Err()
+	_, err = m.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, queueKey, redis.Z{
+			Score:  score,
+			Member: playerID,
+		})
+		// the rating window of the player widens with the time spent in the queue
+		pipe.HSet(ctx, enqueuedAtKey(queueKey), playerID, time.Now().UnixMilli())
+		if queue.Casual && preferred != genprotos.Color_NO_COLOR {
+			pipe.HSet(ctx, preferredColorKey(queueKey), playerID, preferred.String())
+		}
+		return nil
+	})
 	if err != nil {
 		m.logger.Println("Error adding player to queue:", err)
+		m.removeAfterSearch(playerID, queue)
 		return err
 	}
 	return nil
 }
 
-func (m *MatchmakingService) MatchPlayers(ctx context.Context, minDiff, maxDiff int, duration int8) {
+// WaitForMatch blocks until the player receives a match on its channel, cancels the search or the search
+// duration expires, the player is taken out of the queue if no game was found. If progress is not nil it receives
+// the player's queue position and rating window every progressInterval
+func (m *MatchmakingService) WaitForMatch(
+	ctx context.Context,
+	playerID string,
+	queue Queue,
+	playerChannel chan *genprotos.MatchEvent,
+	progress func(*genprotos.MatchEvent) error,
+) (*genprotos.Matched, error) {
+	searchCtx, cancel := context.WithTimeout(ctx, time.Duration(m.config.GameConfig.SearchDuration)*time.Minute)
+	defer cancel()
+
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	if progress != nil {
+		if err := m.sendProgress(searchCtx, playerID, queue, progress); err != nil {
+			m.removeAfterSearch(playerID, queue)
+			return nil, err
+		}
+	}
+
+	for {
+		select {
+		case event := <-playerChannel:
+			if matched := event.GetMatched(); matched != nil {
+				return matched, nil
+			}
+			if event.GetCancelled() != nil {
+				return nil, ErrSearchCancelled
+			}
+		case <-ticker.C:
+			if progress == nil {
+				continue
+			}
+			if err := m.sendProgress(searchCtx, playerID, queue, progress); err != nil {
+				m.removeAfterSearch(playerID, queue)
+				return nil, err
+			}
+		case <-searchCtx.Done():
+			m.removeAfterSearch(playerID, queue)
+
+			// the match could have been delivered while the player was being removed
+			select {
+			case event := <-playerChannel:
+				if matched := event.GetMatched(); matched != nil {
+					return matched, nil
+				}
+				if event.GetCancelled() != nil {
+					return nil, ErrSearchCancelled
+				}
+			default:
+			}
+			return nil, searchCtx.Err()
+		}
+	}
+}
+
+// sendProgress reports the player's current queue position and rating window
+func (m *MatchmakingService) sendProgress(ctx context.Context, playerID string, queue Queue, progress func(*genprotos.MatchEvent) error) error {
+	queueKey := m.queueKey(queue)
+
+	rank, err := m.redisClient.ZRank(ctx, queueKey, playerID).Result()
+	if err == redis.Nil {
+		// the player has already been taken out of the queue by a worker
+		return nil
+	}
+	if err != nil {
+		m.logger.Println("Error reading queue position:", err)
+		return nil
+	}
+	size, err := m.redisClient.ZCard(ctx, queueKey).Result()
+	if err != nil {
+		m.logger.Println("Error reading queue size:", err)
+		return nil
+	}
+
+	if err := progress(&genprotos.MatchEvent{
+		Event: &genprotos.MatchEvent_QueuePosition{QueuePosition: &genprotos.QueuePosition{
+			Position:  rank + 1,
+			QueueSize: size,
+		}},
+	}); err != nil {
+		return err
+	}
+
+	rating, err := m.redisClient.ZScore(ctx, queueKey, playerID).Result()
+	if err != nil {
+		// the player has been matched in the meantime
+		return nil
+	}
+	joined, err := m.redisClient.HGet(ctx, enqueuedAtKey(queueKey), playerID).Int64()
+	if err != nil {
+		return nil
+	}
+	window := m.ratingWindow(time.Since(time.UnixMilli(joined)))
+	return progress(&genprotos.MatchEvent{
+		Event: &genprotos.MatchEvent_RatingWindow{RatingWindow: &genprotos.RatingWindow{
+			MinRating: int32(rating) - window,
+			MaxRating: int32(rating) + window,
+		}},
+	})
+}
+
+// ratingWindow returns the half width of the rating window of a player who has waited for the given time,
+// it has to agree with the window the matchmaking script computes
+func (m *MatchmakingService) ratingWindow(waited time.Duration) int32 {
+	cfg := m.config.GameConfig
+	window := cfg.RankRange + int32(float64(cfg.RangeGrowth)*waited.Seconds())
+	return min(window, cfg.MaxRankRange)
+}
+
+func (m *MatchmakingService) removeAfterSearch(playerID string, queue Queue) {
+	if err := m.RemovePlayer(context.Background(), playerID, queue); err != nil {
+		m.logger.Println("Error removing player from queue:", err)
+	}
+}
+
+// RemovePlayer takes the player out of the queue and forgets its channel
+func (m *MatchmakingService) RemovePlayer(ctx context.Context, playerID string, queue Queue) error {
+	m.forget(playerID)
+
+	queueKey := m.queueKey(queue)
+	_, err := m.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, searchingKey(playerID))
+		pipe.ZRem(ctx, queueKey, playerID)
+		pipe.HDel(ctx, enqueuedAtKey(queueKey), playerID)
+		pipe.HDel(ctx, preferredColorKey(queueKey), playerID)
+		return nil
+	})
+	return err
+}
+
+func (m *MatchmakingService) queueKey(queue Queue) string {
+	// Redis key based on duration (e.g., "score_queue_10min" or "score_queue_10min_casual")
+	key := fmt.Sprintf("%s_%dmin", m.config.GameConfig.ScoreQueue, queue.Duration)
+	if queue.Casual {
+		key += "_casual"
+	}
+	return key
+}
+
+// enqueuedAtKey returns the hash that keeps when each player of the queue joined it
+func enqueuedAtKey(queueKey string) string {
+	return queueKey + ":enqueued_at"
+}
+
+// Queues returns every matchmaking queue, a rated and a casual one for each configured duration
+func (m *MatchmakingService) Queues() []Queue {
+	queues := make([]Queue, 0, 2*len(m.config.GameConfig.Durations))
+	for _, duration := range m.config.GameConfig.Durations {
+		for _, casual := range []bool{false, true} {
+			queues = append(queues, Queue{Duration: int32(duration), Casual: casual})
+		}
+	}
+	return queues
+}
+
+// CancelSearch takes the player out of every queue in one step and ends the player's search
+// on whichever replica it runs. It reports false if the player was not searching
+func (m *MatchmakingService) CancelSearch(ctx context.Context, playerID string) (bool, error) {
+	keys := []string{searchingKey(playerID)}
+	for _, queue := range m.Queues() {
+		queueKey := m.queueKey(queue)
+		keys = append(keys, queueKey, enqueuedAtKey(queueKey), preferredColorKey(queueKey))
+	}
+	removed, err := cancelScript.Run(ctx, m.redisClient, keys, playerID).Int()
+	if err != nil {
+		return false, err
+	}
+	if removed == 0 {
+		return false, nil
+	}
+
+	if err := m.publish(ctx, playerID, matchNotice{Cancelled: true}); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// MatchPlayers runs the matchmaking workers of the queue until ctx is done
+func (m *MatchmakingService) MatchPlayers(ctx context.Context, queue Queue) {
 	var wg sync.WaitGroup
 	m.logger.Println("starting workers")
 	for range m.config.GameConfig.WorkerPoolSize {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := m.matchWorker(ctx, minDiff, maxDiff, duration); err != nil {
+			if err := m.matchWorker(ctx, queue); err != nil {
 				m.logger.Println("could not start worker", err)
 			}
 		}()
@@ -78,68 +315,150 @@ func (m *MatchmakingService) MatchPlayers(ctx context.Context, minDiff, maxDiff
 	wg.Wait()
 }
 
-func (m *MatchmakingService) matchWorker(ctx context.Context, minDiff, maxDiff int, duration int8) error {
+func (m *MatchmakingService) matchWorker(ctx context.Context, queue Queue) error {
 	// Use the correct Redis key based on duration
 	m.logger.Println("worker started")
-	queueKey := fmt.Sprintf("%s_%dmin", m.config.GameConfig.ScoreQueue, duration)
+	queueKey := m.queueKey(queue)
 
 	backoff := 500 * time.Millisecond
 	for {
 		select {
 		case <-ctx.Done():
-			m.logger.Println("could not find an opponent, please retry")
-			return fmt.Errorf("could not find an opponent, please retry")
+			return nil
 		default:
-			players, err := m.redisClient.Eval(ctx, m.luaScript, []string{queueKey},
-				fmt.Sprintf("%d", minDiff), fmt.Sprintf("%d", maxDiff)).Result()
+			cfg := m.config.GameConfig
+			maxWait := time.Duration(cfg.SearchDuration) * time.Minute
+			players, err := m.redisClient.Eval(ctx, m.luaScript,
+				[]string{queueKey, enqueuedAtKey(queueKey), preferredColorKey(queueKey)},
+				time.Now().UnixMilli(), cfg.RankRange, cfg.RangeGrowth, cfg.MaxRankRange, maxWait.Milliseconds()).Result()
 			if err != nil || players == nil {
+				// the script returns nil while no two players fit each other, which is the usual case
+				if err != nil && err != redis.Nil && ctx.Err() == nil {
+					m.logger.Println("Error searching for a match:", err)
+				}
 				time.Sleep(backoff)
 				if backoff < 2*time.Second {
 					backoff *= 2
 				}
-				m.logger.Println("NO PLAYER FOUND", err, players)
 				continue
 			}
 
 			res, ok := players.([]interface{})
-			if !ok || len(res) < 2 {
+			if !ok || len(res) < 6 {
 				m.logger.Println("NOT ENOUGH PLAYERS")
 				continue
 			}
 
-			player1, _ := res[0].(string)
-			player2, _ := res[1].(string)
+			var paired [2]pairedPlayer
+			for i := range paired {
+				paired[i].ID, _ = res[i].(string)
+				paired[i].Score, _ = res[2+i].(string)
+				paired[i].JoinedAt, _ = res[4+i].(string)
+			}
 
-			if err := m.handleMatch(ctx, player1, player2, duration); err != nil {
-				return err
+			claimed, err := m.offerMatch(ctx, paired[0].ID, paired[1].ID)
+			if err != nil {
+				m.logger.Println("Error offering match:", err)
+			}
+			if claimed {
+				// a player could have cancelled after claiming, the search of the other one goes on
+				if claimed, err = m.takeSearches(ctx, paired[0].ID, paired[1].ID); err != nil {
+					m.logger.Println("Error ending searches:", err)
+				}
+			}
+			if !claimed {
+				if err := m.requeue(context.Background(), queue, paired); err != nil {
+					m.logger.Println("Error putting players back into the queue:", err)
+				}
+				continue
+			}
+
+			if err := m.handleMatch(ctx, queue, paired[0].ID, paired[1].ID); err != nil {
+				m.logger.Println("Error creating the game of a match:", err)
+				m.cancelMatch(paired)
+				continue
 			}
 			backoff = 500 * time.Millisecond
 		}
 	}
 }
 
-func (m *MatchmakingService) handleMatch(ctx context.Context, player1, player2 string, duration int8) error {
-	gameId, err := m.storage.CreateGameStorage(ctx, player1, player2, duration)
+// handleMatch creates the game of a claimed match and delivers it, it fails only if the game could not be created
+func (m *MatchmakingService) handleMatch(ctx context.Context, queue Queue, player1, player2 string) error {
+	white, black, err := m.assignColors(ctx, queue, player1, player2)
+	if err != nil {
+		m.logger.Println("Error assigning colours:", err)
+		return err
+	}
+
+	// casual games are unrated and always allow takebacks
+	duration := int8(queue.Duration)
+	gameId, err := m.storage.CreateGameStorage(ctx, white, black, storage.GameSettings{
+		Duration:    duration,
+		TimeControl: m.config.GameConfig.TimeControls[duration],
+		Rated:       !queue.Casual,
+		Takebacks:   queue.Casual || m.config.GameConfig.RatedTakebacks,
+	})
 	if err != nil {
 		m.logger.Println("Error creating game:", err)
 		return err
 	}
+	if err := m.recordColors(ctx, queue, white, black); err != nil {
+		// the game exists already, a missing history entry only makes the next assignment less balanced
+		m.logger.Println("Error recording colours:", err)
+	}
+
+	// the replicas that claimed the match deliver it to the players
+	if err := m.publish(ctx, white, matchNotice{GameID: gameId, Color: genprotos.Color_WHITE, OpponentID: black}); err != nil {
+		m.logger.Println("Error publishing match:", err)
+	}
+	if err := m.publish(ctx, black, matchNotice{GameID: gameId, Color: genprotos.Color_BLACK, OpponentID: white}); err != nil {
+		m.logger.Println("Error publishing match:", err)
+	}
+
+	if err := m.redisClient.Publish(ctx, m.config.GameConfig.RedisChannel,
+		fmt.Sprintf("%s:%s:%s", white, black, gameId)).Err(); err != nil {
+		m.logger.Println("Error publishing game:", err)
+	}
+	return nil
+}
 
+// notifyPlayer delivers the event without blocking and forgets the player's channel
+func (m *MatchmakingService) notifyPlayer(playerID string, event *genprotos.MatchEvent) {
 	m.mutex.Lock()
-	if ch1, ok := m.playerChannels[player1]; ok {
-		m.logger.Println("response is sent to channel")
-		ch1 <- gameId
-	} else {
+	ch, ok := m.playerChannels[playerID]
+	m.mutex.Unlock()
+	if !ok {
 		m.logger.Println("channel not found")
+		return
 	}
-	if ch2, ok := m.playerChannels[player2]; ok {
+	m.forget(playerID)
+
+	select {
+	case ch <- event:
 		m.logger.Println("response is sent to channel")
-		ch2 <- gameId
-	} else {
-		m.logger.Println("channel not found")
+	default:
+		m.logger.Println("channel is full, response is dropped")
 	}
+}
+
+// forget drops the player's channel and stops listening for its matches
+func (m *MatchmakingService) forget(playerID string) {
+	m.mutex.Lock()
+	delete(m.playerChannels, playerID)
 	m.mutex.Unlock()
 
-	return m.redisClient.Publish(ctx, m.config.GameConfig.RedisChannel,
-		fmt.Sprintf("%s:%s:%s", player1, player2, gameId)).Err()
+	if err := m.pubsub.Unsubscribe(context.Background(), matchChannel(playerID)); err != nil {
+		m.logger.Println("Error unsubscribing from match channel:", err)
+	}
+}
+
+func matchedEvent(gameID string, color genprotos.Color, opponentID string) *genprotos.MatchEvent {
+	return &genprotos.MatchEvent{
+		Event: &genprotos.MatchEvent_Matched{Matched: &genprotos.Matched{
+			GameId:     gameID,
+			Color:      color,
+			OpponentId: opponentID,
+		}},
+	}
 }
@@ -9,7 +9,6 @@ package genprotos
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +21,284 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Color int32
+
+const (
+	Color_NO_COLOR Color = 0
+	Color_WHITE    Color = 1
+	Color_BLACK    Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "NO_COLOR",
+		1: "WHITE",
+		2: "BLACK",
+	}
+	Color_value = map[string]int32{
+		"NO_COLOR": 0,
+		"WHITE":    1,
+		"BLACK":    2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{0}
+}
+
+type PlayerResult int32
+
+const (
+	PlayerResult_ANY_RESULT PlayerResult = 0
+	PlayerResult_WON        PlayerResult = 1
+	PlayerResult_LOST       PlayerResult = 2
+	PlayerResult_DRAWN      PlayerResult = 3
+)
+
+// Enum value maps for PlayerResult.
+var (
+	PlayerResult_name = map[int32]string{
+		0: "ANY_RESULT",
+		1: "WON",
+		2: "LOST",
+		3: "DRAWN",
+	}
+	PlayerResult_value = map[string]int32{
+		"ANY_RESULT": 0,
+		"WON":        1,
+		"LOST":       2,
+		"DRAWN":      3,
+	}
+)
+
+func (x PlayerResult) Enum() *PlayerResult {
+	p := new(PlayerResult)
+	*p = x
+	return p
+}
+
+func (x PlayerResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[1].Descriptor()
+}
+
+func (PlayerResult) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[1]
+}
+
+func (x PlayerResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerResult.Descriptor instead.
+func (PlayerResult) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_PGN    ExportFormat = 0
+	ExportFormat_NDJSON ExportFormat = 1 // one JSON object per line
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "PGN",
+		1: "NDJSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"PGN":    0,
+		"NDJSON": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{2}
+}
+
+type GameOutcome int32
+
+const (
+	GameOutcome_ONGOING   GameOutcome = 0
+	GameOutcome_WHITE_WON GameOutcome = 1
+	GameOutcome_BLACK_WON GameOutcome = 2
+	GameOutcome_DRAW      GameOutcome = 3
+)
+
+// Enum value maps for GameOutcome.
+var (
+	GameOutcome_name = map[int32]string{
+		0: "ONGOING",
+		1: "WHITE_WON",
+		2: "BLACK_WON",
+		3: "DRAW",
+	}
+	GameOutcome_value = map[string]int32{
+		"ONGOING":   0,
+		"WHITE_WON": 1,
+		"BLACK_WON": 2,
+		"DRAW":      3,
+	}
+)
+
+func (x GameOutcome) Enum() *GameOutcome {
+	p := new(GameOutcome)
+	*p = x
+	return p
+}
+
+func (x GameOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[3].Descriptor()
+}
+
+func (GameOutcome) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[3]
+}
+
+func (x GameOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOutcome.Descriptor instead.
+func (GameOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{3}
+}
+
+type Termination int32
+
+const (
+	Termination_NO_TERMINATION         Termination = 0
+	Termination_CHECKMATE              Termination = 1
+	Termination_RESIGNATION            Termination = 2
+	Termination_DRAW_AGREEMENT         Termination = 3
+	Termination_STALEMATE              Termination = 4
+	Termination_THREEFOLD_REPETITION   Termination = 5
+	Termination_FIVEFOLD_REPETITION    Termination = 6
+	Termination_FIFTY_MOVE_RULE        Termination = 7
+	Termination_SEVENTY_FIVE_MOVE_RULE Termination = 8
+	Termination_INSUFFICIENT_MATERIAL  Termination = 9
+	Termination_TIMEOUT                Termination = 10 // a player ran out of time, it is a draw if the opponent cannot mate
+	Termination_ABORTED                Termination = 11 // the game was called off before both players moved, it has no result
+	Termination_ABANDONMENT            Termination = 12 // a player left the game
+)
+
+// Enum value maps for Termination.
+var (
+	Termination_name = map[int32]string{
+		0:  "NO_TERMINATION",
+		1:  "CHECKMATE",
+		2:  "RESIGNATION",
+		3:  "DRAW_AGREEMENT",
+		4:  "STALEMATE",
+		5:  "THREEFOLD_REPETITION",
+		6:  "FIVEFOLD_REPETITION",
+		7:  "FIFTY_MOVE_RULE",
+		8:  "SEVENTY_FIVE_MOVE_RULE",
+		9:  "INSUFFICIENT_MATERIAL",
+		10: "TIMEOUT",
+		11: "ABORTED",
+		12: "ABANDONMENT",
+	}
+	Termination_value = map[string]int32{
+		"NO_TERMINATION":         0,
+		"CHECKMATE":              1,
+		"RESIGNATION":            2,
+		"DRAW_AGREEMENT":         3,
+		"STALEMATE":              4,
+		"THREEFOLD_REPETITION":   5,
+		"FIVEFOLD_REPETITION":    6,
+		"FIFTY_MOVE_RULE":        7,
+		"SEVENTY_FIVE_MOVE_RULE": 8,
+		"INSUFFICIENT_MATERIAL":  9,
+		"TIMEOUT":                10,
+		"ABORTED":                11,
+		"ABANDONMENT":            12,
+	}
+)
+
+func (x Termination) Enum() *Termination {
+	p := new(Termination)
+	*p = x
+	return p
+}
+
+func (x Termination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Termination) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[4].Descriptor()
+}
+
+func (Termination) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[4]
+}
+
+func (x Termination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Termination.Descriptor instead.
+func (Termination) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{4}
+}
+
 type PieceType int32
 
 const (
@@ -63,47 +340,4299 @@ func (x PieceType) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (PieceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_game_protos_proto_enumTypes[0].Descriptor()
+func (PieceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_protos_proto_enumTypes[5].Descriptor()
+}
+
+func (PieceType) Type() protoreflect.EnumType {
+	return &file_game_protos_proto_enumTypes[5]
+}
+
+func (x PieceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PieceType.Descriptor instead.
+func (PieceType) EnumDescriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{5}
+}
+
+type Move struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MoveFrom      string                 `protobuf:"bytes,1,opt,name=move_from,json=moveFrom,proto3" json:"move_from,omitempty"`
+	MoveTo        string                 `protobuf:"bytes,2,opt,name=move_to,json=moveTo,proto3" json:"move_to,omitempty"`
+	IsWhite       bool                   `protobuf:"varint,3,opt,name=is_white,json=isWhite,proto3" json:"is_white,omitempty"`
+	Promotion     *PieceType             `protobuf:"varint,4,opt,name=promotion,proto3,enum=game.PieceType,oneof" json:"promotion,omitempty"` // piece the pawn is promoted to when move_to is on the last rank
+	San           string                 `protobuf:"bytes,5,opt,name=san,proto3" json:"san,omitempty"`                                        // alternative to move_from/move_to, e.g. "Nf3" or "e8=Q"
+	Uci           string                 `protobuf:"bytes,6,opt,name=uci,proto3" json:"uci,omitempty"`                                        // alternative to move_from/move_to, e.g. "g1f3" or "e7e8q"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	mi := &file_game_protos_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Move) GetMoveFrom() string {
+	if x != nil {
+		return x.MoveFrom
+	}
+	return ""
+}
+
+func (x *Move) GetMoveTo() string {
+	if x != nil {
+		return x.MoveTo
+	}
+	return ""
+}
+
+func (x *Move) GetIsWhite() bool {
+	if x != nil {
+		return x.IsWhite
+	}
+	return false
+}
+
+func (x *Move) GetPromotion() PieceType {
+	if x != nil && x.Promotion != nil {
+		return *x.Promotion
+	}
+	return PieceType_PAWN
+}
+
+func (x *Move) GetSan() string {
+	if x != nil {
+		return x.San
+	}
+	return ""
+}
+
+func (x *Move) GetUci() string {
+	if x != nil {
+		return x.Uci
+	}
+	return ""
+}
+
+type CreateGameRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerRank     int32                  `protobuf:"varint,2,opt,name=player_rank,json=playerRank,proto3" json:"player_rank,omitempty"` // ignored, the player's rating for the duration is looked up on the server
+	Duration       int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Casual         bool                   `protobuf:"varint,4,opt,name=casual,proto3" json:"casual,omitempty"`                                                       // casual games are unrated and matched in their own queue
+	PreferredColor Color                  `protobuf:"varint,5,opt,name=preferred_color,json=preferredColor,proto3,enum=game.Color" json:"preferred_color,omitempty"` // only honoured in casual games, NO_COLOR if the player does not mind
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	mi := &file_game_protos_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetPlayerRank() int32 {
+	if x != nil {
+		return x.PlayerRank
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetCasual() bool {
+	if x != nil {
+		return x.Casual
+	}
+	return false
+}
+
+func (x *CreateGameRequest) GetPreferredColor() Color {
+	if x != nil {
+		return x.PreferredColor
+	}
+	return Color_NO_COLOR
+}
+
+type CreateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	mi := &file_game_protos_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type CancelSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSearchRequest) Reset() {
+	*x = CancelSearchRequest{}
+	mi := &file_game_protos_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSearchRequest) ProtoMessage() {}
+
+func (x *CancelSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSearchRequest.ProtoReflect.Descriptor instead.
+func (*CancelSearchRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelSearchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type CancelSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     bool                   `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // false if the player was not searching
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSearchResponse) Reset() {
+	*x = CancelSearchResponse{}
+	mi := &file_game_protos_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSearchResponse) ProtoMessage() {}
+
+func (x *CancelSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSearchResponse.ProtoReflect.Descriptor instead.
+func (*CancelSearchResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelSearchResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type CreateChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengerId  string                 `protobuf:"bytes,1,opt,name=challenger_id,json=challengerId,proto3" json:"challenger_id,omitempty"`
+	ChallengedId  string                 `protobuf:"bytes,2,opt,name=challenged_id,json=challengedId,proto3" json:"challenged_id,omitempty"`
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // one of the durations of the matchmaking queues
+	Rated         bool                   `protobuf:"varint,4,opt,name=rated,proto3" json:"rated,omitempty"`
+	Color         Color                  `protobuf:"varint,5,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"` // colour the challenger wants to play, NO_COLOR for a random one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChallengeRequest) Reset() {
+	*x = CreateChallengeRequest{}
+	mi := &file_game_protos_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChallengeRequest) ProtoMessage() {}
+
+func (x *CreateChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateChallengeRequest) GetChallengerId() string {
+	if x != nil {
+		return x.ChallengerId
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetChallengedId() string {
+	if x != nil {
+		return x.ChallengedId
+	}
+	return ""
+}
+
+func (x *CreateChallengeRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateChallengeRequest) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *CreateChallengeRequest) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+type Challenge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChallengerId  string                 `protobuf:"bytes,2,opt,name=challenger_id,json=challengerId,proto3" json:"challenger_id,omitempty"`
+	ChallengedId  string                 `protobuf:"bytes,3,opt,name=challenged_id,json=challengedId,proto3" json:"challenged_id,omitempty"`
+	Duration      int32                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rated         bool                   `protobuf:"varint,5,opt,name=rated,proto3" json:"rated,omitempty"`
+	Color         Color                  `protobuf:"varint,6,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"`                  // colour the challenger asked for
+	ExpiresAtMs   int64                  `protobuf:"varint,7,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"` // unix milliseconds the challenge can be accepted until
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_game_protos_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Challenge) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *Challenge) GetChallengerId() string {
+	if x != nil {
+		return x.ChallengerId
+	}
+	return ""
+}
+
+func (x *Challenge) GetChallengedId() string {
+	if x != nil {
+		return x.ChallengedId
+	}
+	return ""
+}
+
+func (x *Challenge) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Challenge) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *Challenge) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+func (x *Challenge) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+type ChallengeActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeActionRequest) Reset() {
+	*x = ChallengeActionRequest{}
+	mi := &file_game_protos_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeActionRequest) ProtoMessage() {}
+
+func (x *ChallengeActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeActionRequest.ProtoReflect.Descriptor instead.
+func (*ChallengeActionRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChallengeActionRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeActionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type AcceptChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Color         Color                  `protobuf:"varint,2,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"` // colour of the accepting player
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptChallengeResponse) Reset() {
+	*x = AcceptChallengeResponse{}
+	mi := &file_game_protos_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptChallengeResponse) ProtoMessage() {}
+
+func (x *AcceptChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptChallengeResponse.ProtoReflect.Descriptor instead.
+func (*AcceptChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptChallengeResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AcceptChallengeResponse) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+type DeclineChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineChallengeResponse) Reset() {
+	*x = DeclineChallengeResponse{}
+	mi := &file_game_protos_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineChallengeResponse) ProtoMessage() {}
+
+func (x *DeclineChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineChallengeResponse.ProtoReflect.Descriptor instead.
+func (*DeclineChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{9}
+}
+
+type ListIncomingChallengesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomingChallengesRequest) Reset() {
+	*x = ListIncomingChallengesRequest{}
+	mi := &file_game_protos_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingChallengesRequest) ProtoMessage() {}
+
+func (x *ListIncomingChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingChallengesRequest.ProtoReflect.Descriptor instead.
+func (*ListIncomingChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListIncomingChallengesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ListIncomingChallengesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenges    []*Challenge           `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges,omitempty"` // the oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomingChallengesResponse) Reset() {
+	*x = ListIncomingChallengesResponse{}
+	mi := &file_game_protos_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingChallengesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingChallengesResponse) ProtoMessage() {}
+
+func (x *ListIncomingChallengesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingChallengesResponse.ProtoReflect.Descriptor instead.
+func (*ListIncomingChallengesResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListIncomingChallengesResponse) GetChallenges() []*Challenge {
+	if x != nil {
+		return x.Challenges
+	}
+	return nil
+}
+
+type WatchChallengesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChallengesRequest) Reset() {
+	*x = WatchChallengesRequest{}
+	mi := &file_game_protos_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChallengesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChallengesRequest) ProtoMessage() {}
+
+func (x *WatchChallengesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChallengesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChallengesRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchChallengesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ChallengeAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`           // declined if not set
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`  // game of an accepted challenge
+	Color         Color                  `protobuf:"varint,4,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"` // colour of the challenger in that game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeAnswer) Reset() {
+	*x = ChallengeAnswer{}
+	mi := &file_game_protos_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeAnswer) ProtoMessage() {}
+
+func (x *ChallengeAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeAnswer.ProtoReflect.Descriptor instead.
+func (*ChallengeAnswer) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChallengeAnswer) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ChallengeAnswer) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ChallengeAnswer) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ChallengeAnswer) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+type GetRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsRequest) Reset() {
+	*x = GetRatingsRequest{}
+	mi := &file_game_protos_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsRequest) ProtoMessage() {}
+
+func (x *GetRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRatingsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*PlayerRating        `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"` // one for every duration the player has played rated games of
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsResponse) Reset() {
+	*x = GetRatingsResponse{}
+	mi := &file_game_protos_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsResponse) ProtoMessage() {}
+
+func (x *GetRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRatingsResponse) GetRatings() []*PlayerRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type PlayerRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duration      int32                  `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rating        float64                `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Deviation     float64                `protobuf:"fixed64,3,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility    float64                `protobuf:"fixed64,4,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	Games         int32                  `protobuf:"varint,5,opt,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRating) Reset() {
+	*x = PlayerRating{}
+	mi := &file_game_protos_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRating) ProtoMessage() {}
+
+func (x *PlayerRating) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRating.ProtoReflect.Descriptor instead.
+func (*PlayerRating) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerRating) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PlayerRating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerRating) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *PlayerRating) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *PlayerRating) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+type MatchEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*MatchEvent_Queued
+	//	*MatchEvent_QueuePosition
+	//	*MatchEvent_RatingWindow
+	//	*MatchEvent_Matched
+	//	*MatchEvent_Timeout
+	//	*MatchEvent_Cancelled
+	Event         isMatchEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchEvent) Reset() {
+	*x = MatchEvent{}
+	mi := &file_game_protos_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchEvent) ProtoMessage() {}
+
+func (x *MatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
+func (*MatchEvent) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MatchEvent) GetEvent() isMatchEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetQueued() *Queued {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_Queued); ok {
+			return x.Queued
+		}
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetQueuePosition() *QueuePosition {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_QueuePosition); ok {
+			return x.QueuePosition
+		}
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetRatingWindow() *RatingWindow {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_RatingWindow); ok {
+			return x.RatingWindow
+		}
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetMatched() *Matched {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_Matched); ok {
+			return x.Matched
+		}
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetTimeout() *Timeout {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_Timeout); ok {
+			return x.Timeout
+		}
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetCancelled() *Cancelled {
+	if x != nil {
+		if x, ok := x.Event.(*MatchEvent_Cancelled); ok {
+			return x.Cancelled
+		}
+	}
+	return nil
+}
+
+type isMatchEvent_Event interface {
+	isMatchEvent_Event()
+}
+
+type MatchEvent_Queued struct {
+	Queued *Queued `protobuf:"bytes,1,opt,name=queued,proto3,oneof"`
+}
+
+type MatchEvent_QueuePosition struct {
+	QueuePosition *QueuePosition `protobuf:"bytes,2,opt,name=queue_position,json=queuePosition,proto3,oneof"`
+}
+
+type MatchEvent_RatingWindow struct {
+	RatingWindow *RatingWindow `protobuf:"bytes,3,opt,name=rating_window,json=ratingWindow,proto3,oneof"`
+}
+
+type MatchEvent_Matched struct {
+	Matched *Matched `protobuf:"bytes,4,opt,name=matched,proto3,oneof"`
+}
+
+type MatchEvent_Timeout struct {
+	Timeout *Timeout `protobuf:"bytes,5,opt,name=timeout,proto3,oneof"`
+}
+
+type MatchEvent_Cancelled struct {
+	Cancelled *Cancelled `protobuf:"bytes,6,opt,name=cancelled,proto3,oneof"`
+}
+
+func (*MatchEvent_Queued) isMatchEvent_Event() {}
+
+func (*MatchEvent_QueuePosition) isMatchEvent_Event() {}
+
+func (*MatchEvent_RatingWindow) isMatchEvent_Event() {}
+
+func (*MatchEvent_Matched) isMatchEvent_Event() {}
+
+func (*MatchEvent_Timeout) isMatchEvent_Event() {}
+
+func (*MatchEvent_Cancelled) isMatchEvent_Event() {}
+
+type Queued struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duration      int32                  `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Queued) Reset() {
+	*x = Queued{}
+	mi := &file_game_protos_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queued) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queued) ProtoMessage() {}
+
+func (x *Queued) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queued.ProtoReflect.Descriptor instead.
+func (*Queued) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Queued) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type QueuePosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int64                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // 1-based position of the player in the queue
+	QueueSize     int64                  `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuePosition) Reset() {
+	*x = QueuePosition{}
+	mi := &file_game_protos_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuePosition) ProtoMessage() {}
+
+func (x *QueuePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuePosition.ProtoReflect.Descriptor instead.
+func (*QueuePosition) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueuePosition) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueuePosition) GetQueueSize() int64 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+type RatingWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinRating     int32                  `protobuf:"varint,1,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	MaxRating     int32                  `protobuf:"varint,2,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingWindow) Reset() {
+	*x = RatingWindow{}
+	mi := &file_game_protos_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingWindow) ProtoMessage() {}
+
+func (x *RatingWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingWindow.ProtoReflect.Descriptor instead.
+func (*RatingWindow) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RatingWindow) GetMinRating() int32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *RatingWindow) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+type Matched struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Color         Color                  `protobuf:"varint,2,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"`
+	OpponentId    string                 `protobuf:"bytes,3,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Matched) Reset() {
+	*x = Matched{}
+	mi := &file_game_protos_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Matched) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matched) ProtoMessage() {}
+
+func (x *Matched) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matched.ProtoReflect.Descriptor instead.
+func (*Matched) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Matched) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Matched) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+func (x *Matched) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+type Timeout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Timeout) Reset() {
+	*x = Timeout{}
+	mi := &file_game_protos_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Timeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timeout) ProtoMessage() {}
+
+func (x *Timeout) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timeout.ProtoReflect.Descriptor instead.
+func (*Timeout) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{22}
+}
+
+type Cancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cancelled) Reset() {
+	*x = Cancelled{}
+	mi := &file_game_protos_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancelled) ProtoMessage() {}
+
+func (x *Cancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancelled.ProtoReflect.Descriptor instead.
+func (*Cancelled) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{23}
+}
+
+type MakeMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Move          *Move                  `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeMoveRequest) Reset() {
+	*x = MakeMoveRequest{}
+	mi := &file_game_protos_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeMoveRequest) ProtoMessage() {}
+
+func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeMoveRequest.ProtoReflect.Descriptor instead.
+func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MakeMoveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MakeMoveRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MakeMoveRequest) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+type MakeMoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	IsCheck       bool                   `protobuf:"varint,3,opt,name=is_check,json=isCheck,proto3" json:"is_check,omitempty"`             // this is needed in frontend, if the move is a check, then move sound will be different than a basic move
+	IsCheckmate   bool                   `protobuf:"varint,4,opt,name=is_checkmate,json=isCheckmate,proto3" json:"is_checkmate,omitempty"` // determine if after this move the game is finished with checkmate
+	Uci           string                 `protobuf:"bytes,5,opt,name=uci,proto3" json:"uci,omitempty"`                                     // the move that was played in UCI notation
+	San           string                 `protobuf:"bytes,6,opt,name=san,proto3" json:"san,omitempty"`                                     // the move that was played in standard algebraic notation
+	Outcome       GameOutcome            `protobuf:"varint,7,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"`      // ONGOING unless the move has ended the game
+	Termination   Termination            `protobuf:"varint,8,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
+	WhiteClockMs  int64                  `protobuf:"varint,9,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"` // remaining time of white after the move
+	BlackClockMs  int64                  `protobuf:"varint,10,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeMoveResponse) Reset() {
+	*x = MakeMoveResponse{}
+	mi := &file_game_protos_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeMoveResponse) ProtoMessage() {}
+
+func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeMoveResponse.ProtoReflect.Descriptor instead.
+func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MakeMoveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MakeMoveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MakeMoveResponse) GetIsCheck() bool {
+	if x != nil {
+		return x.IsCheck
+	}
+	return false
+}
+
+func (x *MakeMoveResponse) GetIsCheckmate() bool {
+	if x != nil {
+		return x.IsCheckmate
+	}
+	return false
+}
+
+func (x *MakeMoveResponse) GetUci() string {
+	if x != nil {
+		return x.Uci
+	}
+	return ""
+}
+
+func (x *MakeMoveResponse) GetSan() string {
+	if x != nil {
+		return x.San
+	}
+	return ""
+}
+
+func (x *MakeMoveResponse) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_ONGOING
+}
+
+func (x *MakeMoveResponse) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+func (x *MakeMoveResponse) GetWhiteClockMs() int64 {
+	if x != nil {
+		return x.WhiteClockMs
+	}
+	return 0
+}
+
+func (x *MakeMoveResponse) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
+}
+
+type GetGameStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatsRequest) Reset() {
+	*x = GetGameStatsRequest{}
+	mi := &file_game_protos_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatsRequest) ProtoMessage() {}
+
+func (x *GetGameStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStatsRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetGameStatsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*Move                `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatsResponse) Reset() {
+	*x = GetGameStatsResponse{}
+	mi := &file_game_protos_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatsResponse) ProtoMessage() {}
+
+func (x *GetGameStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStatsResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetGameStatsResponse) GetMoves() []*Move {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	mi := &file_game_protos_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	White         *GamePlayer            `protobuf:"bytes,2,opt,name=white,proto3" json:"white,omitempty"`
+	Black         *GamePlayer            `protobuf:"bytes,3,opt,name=black,proto3" json:"black,omitempty"`
+	TimeControl   *GameTimeControl       `protobuf:"bytes,4,opt,name=time_control,json=timeControl,proto3" json:"time_control,omitempty"`
+	Rated         bool                   `protobuf:"varint,5,opt,name=rated,proto3" json:"rated,omitempty"`
+	StartFen      string                 `protobuf:"bytes,6,opt,name=start_fen,json=startFen,proto3" json:"start_fen,omitempty"`
+	Fen           string                 `protobuf:"bytes,7,opt,name=fen,proto3" json:"fen,omitempty"` // current position
+	Turn          Color                  `protobuf:"varint,8,opt,name=turn,proto3,enum=game.Color" json:"turn,omitempty"`
+	LegalMoves    int32                  `protobuf:"varint,9,opt,name=legal_moves,json=legalMoves,proto3" json:"legal_moves,omitempty"`          // number of legal moves in the current position
+	WhiteClockMs  int64                  `protobuf:"varint,10,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"` // remaining times, as of now while the game is in progress
+	BlackClockMs  int64                  `protobuf:"varint,11,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // "ongoing", "finished" or "aborted"
+	Outcome       GameOutcome            `protobuf:"varint,13,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"`
+	Termination   Termination            `protobuf:"varint,14,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
+	Moves         []*GameMove            `protobuf:"bytes,15,rep,name=moves,proto3" json:"moves,omitempty"`                                   // taken back moves are left out
+	StartedAtMs   int64                  `protobuf:"varint,16,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"` // unix milliseconds
+	EndedAtMs     int64                  `protobuf:"varint,17,opt,name=ended_at_ms,json=endedAtMs,proto3" json:"ended_at_ms,omitempty"`       // unix milliseconds, 0 while the game is in progress
+	Imported      bool                   `protobuf:"varint,18,opt,name=imported,proto3" json:"imported,omitempty"`                            // the game was imported from PGN and not played here
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameResponse) Reset() {
+	*x = GetGameResponse{}
+	mi := &file_game_protos_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameResponse) ProtoMessage() {}
+
+func (x *GetGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameResponse.ProtoReflect.Descriptor instead.
+func (*GetGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetGameResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetWhite() *GamePlayer {
+	if x != nil {
+		return x.White
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetBlack() *GamePlayer {
+	if x != nil {
+		return x.Black
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetTimeControl() *GameTimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *GetGameResponse) GetStartFen() string {
+	if x != nil {
+		return x.StartFen
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetFen() string {
+	if x != nil {
+		return x.Fen
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetTurn() Color {
+	if x != nil {
+		return x.Turn
+	}
+	return Color_NO_COLOR
+}
+
+func (x *GetGameResponse) GetLegalMoves() int32 {
+	if x != nil {
+		return x.LegalMoves
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetWhiteClockMs() int64 {
+	if x != nil {
+		return x.WhiteClockMs
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetGameResponse) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_ONGOING
+}
+
+func (x *GetGameResponse) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+func (x *GetGameResponse) GetMoves() []*GameMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *GetGameResponse) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetEndedAtMs() int64 {
+	if x != nil {
+		return x.EndedAtMs
+	}
+	return 0
+}
+
+func (x *GetGameResponse) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+type GamePlayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Color         Color                  `protobuf:"varint,2,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                           // rating before the game once a rated game changed it, the current rating otherwise
+	RatingDiff    int32                  `protobuf:"varint,4,opt,name=rating_diff,json=ratingDiff,proto3" json:"rating_diff,omitempty"` // how the game changed the rating, 0 until a rated game is over
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GamePlayer) Reset() {
+	*x = GamePlayer{}
+	mi := &file_game_protos_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GamePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayer) ProtoMessage() {}
+
+func (x *GamePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
+func (*GamePlayer) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GamePlayer) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+func (x *GamePlayer) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetRatingDiff() int32 {
+	if x != nil {
+		return x.RatingDiff
+	}
+	return 0
+}
+
+type GameTimeControl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duration      int32                  `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"` // minutes
+	BaseMs        int64                  `protobuf:"varint,2,opt,name=base_ms,json=baseMs,proto3" json:"base_ms,omitempty"`
+	IncrementMs   int64                  `protobuf:"varint,3,opt,name=increment_ms,json=incrementMs,proto3" json:"increment_ms,omitempty"`
+	DelayMs       int64                  `protobuf:"varint,4,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameTimeControl) Reset() {
+	*x = GameTimeControl{}
+	mi := &file_game_protos_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTimeControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTimeControl) ProtoMessage() {}
+
+func (x *GameTimeControl) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTimeControl.ProtoReflect.Descriptor instead.
+func (*GameTimeControl) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GameTimeControl) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GameTimeControl) GetBaseMs() int64 {
+	if x != nil {
+		return x.BaseMs
+	}
+	return 0
+}
+
+func (x *GameTimeControl) GetIncrementMs() int64 {
+	if x != nil {
+		return x.IncrementMs
+	}
+	return 0
+}
+
+func (x *GameTimeControl) GetDelayMs() int64 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+type GameMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uci           string                 `protobuf:"bytes,1,opt,name=uci,proto3" json:"uci,omitempty"`
+	San           string                 `protobuf:"bytes,2,opt,name=san,proto3" json:"san,omitempty"`
+	PlayedAtMs    int64                  `protobuf:"varint,3,opt,name=played_at_ms,json=playedAtMs,proto3" json:"played_at_ms,omitempty"` // unix milliseconds
+	ClockMs       int64                  `protobuf:"varint,4,opt,name=clock_ms,json=clockMs,proto3" json:"clock_ms,omitempty"`            // time the mover had left after the move
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMove) Reset() {
+	*x = GameMove{}
+	mi := &file_game_protos_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMove) ProtoMessage() {}
+
+func (x *GameMove) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
+func (*GameMove) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GameMove) GetUci() string {
+	if x != nil {
+		return x.Uci
+	}
+	return ""
+}
+
+func (x *GameMove) GetSan() string {
+	if x != nil {
+		return x.San
+	}
+	return ""
+}
+
+func (x *GameMove) GetPlayedAtMs() int64 {
+	if x != nil {
+		return x.PlayedAtMs
+	}
+	return 0
+}
+
+func (x *GameMove) GetClockMs() int64 {
+	if x != nil {
+		return x.ClockMs
+	}
+	return 0
+}
+
+type ListGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	OpponentId    string                 `protobuf:"bytes,2,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"` // optional, only games against this player
+	Color         Color                  `protobuf:"varint,3,opt,name=color,proto3,enum=game.Color" json:"color,omitempty"`            // optional, only games the player played with this colour
+	Result        PlayerResult           `protobuf:"varint,4,opt,name=result,proto3,enum=game.PlayerResult" json:"result,omitempty"`
+	Duration      int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`                 // optional, only games of this duration in minutes
+	Rated         *bool                  `protobuf:"varint,6,opt,name=rated,proto3,oneof" json:"rated,omitempty"`                 // only rated or only casual games, both if not set
+	SinceMs       int64                  `protobuf:"varint,7,opt,name=since_ms,json=sinceMs,proto3" json:"since_ms,omitempty"`    // optional, only games started at or after this unix millisecond
+	UntilMs       int64                  `protobuf:"varint,8,opt,name=until_ms,json=untilMs,proto3" json:"until_ms,omitempty"`    // optional, only games started before this unix millisecond
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 20 if not set, at most 100
+	Cursor        string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // next_cursor of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesRequest) Reset() {
+	*x = ListGamesRequest{}
+	mi := &file_game_protos_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesRequest) ProtoMessage() {}
+
+func (x *ListGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListGamesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *ListGamesRequest) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return Color_NO_COLOR
+}
+
+func (x *ListGamesRequest) GetResult() PlayerResult {
+	if x != nil {
+		return x.Result
+	}
+	return PlayerResult_ANY_RESULT
+}
+
+func (x *ListGamesRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetRated() bool {
+	if x != nil && x.Rated != nil {
+		return *x.Rated
+	}
+	return false
+}
+
+func (x *ListGamesRequest) GetSinceMs() int64 {
+	if x != nil {
+		return x.SinceMs
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetUntilMs() int64 {
+	if x != nil {
+		return x.UntilMs
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGamesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameSummary         `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGamesResponse) Reset() {
+	*x = ListGamesResponse{}
+	mi := &file_game_protos_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGamesResponse) ProtoMessage() {}
+
+func (x *ListGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListGamesResponse) GetGames() []*GameSummary {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *ListGamesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GameSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameId          string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	WhiteId         string                 `protobuf:"bytes,2,opt,name=white_id,json=whiteId,proto3" json:"white_id,omitempty"`
+	BlackId         string                 `protobuf:"bytes,3,opt,name=black_id,json=blackId,proto3" json:"black_id,omitempty"`
+	Duration        int32                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	TimeControl     string                 `protobuf:"bytes,5,opt,name=time_control,json=timeControl,proto3" json:"time_control,omitempty"` // base+increment in seconds, e.g. "180+2"
+	Rated           bool                   `protobuf:"varint,6,opt,name=rated,proto3" json:"rated,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Outcome         GameOutcome            `protobuf:"varint,8,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"`
+	Termination     Termination            `protobuf:"varint,9,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
+	Moves           int32                  `protobuf:"varint,10,opt,name=moves,proto3" json:"moves,omitempty"` // number of half-moves played, not counting taken back ones
+	StartedAtMs     int64                  `protobuf:"varint,11,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	EndedAtMs       int64                  `protobuf:"varint,12,opt,name=ended_at_ms,json=endedAtMs,proto3" json:"ended_at_ms,omitempty"`     // 0 while the game is in progress
+	WhiteRating     int32                  `protobuf:"varint,13,opt,name=white_rating,json=whiteRating,proto3" json:"white_rating,omitempty"` // set once a rated game changed the ratings
+	BlackRating     int32                  `protobuf:"varint,14,opt,name=black_rating,json=blackRating,proto3" json:"black_rating,omitempty"`
+	WhiteRatingDiff int32                  `protobuf:"varint,15,opt,name=white_rating_diff,json=whiteRatingDiff,proto3" json:"white_rating_diff,omitempty"`
+	BlackRatingDiff int32                  `protobuf:"varint,16,opt,name=black_rating_diff,json=blackRatingDiff,proto3" json:"black_rating_diff,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
+	mi := &file_game_protos_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary) ProtoMessage() {}
+
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GameSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetWhiteId() string {
+	if x != nil {
+		return x.WhiteId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetBlackId() string {
+	if x != nil {
+		return x.BlackId
+	}
+	return ""
+}
+
+func (x *GameSummary) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GameSummary) GetTimeControl() string {
+	if x != nil {
+		return x.TimeControl
+	}
+	return ""
+}
+
+func (x *GameSummary) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *GameSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GameSummary) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_ONGOING
+}
+
+func (x *GameSummary) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+func (x *GameSummary) GetMoves() int32 {
+	if x != nil {
+		return x.Moves
+	}
+	return 0
+}
+
+func (x *GameSummary) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *GameSummary) GetEndedAtMs() int64 {
+	if x != nil {
+		return x.EndedAtMs
+	}
+	return 0
+}
+
+func (x *GameSummary) GetWhiteRating() int32 {
+	if x != nil {
+		return x.WhiteRating
+	}
+	return 0
+}
+
+func (x *GameSummary) GetBlackRating() int32 {
+	if x != nil {
+		return x.BlackRating
+	}
+	return 0
+}
+
+func (x *GameSummary) GetWhiteRatingDiff() int32 {
+	if x != nil {
+		return x.WhiteRatingDiff
+	}
+	return 0
+}
+
+func (x *GameSummary) GetBlackRatingDiff() int32 {
+	if x != nil {
+		return x.BlackRatingDiff
+	}
+	return 0
+}
+
+type ExportPGNRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPGNRequest) Reset() {
+	*x = ExportPGNRequest{}
+	mi := &file_game_protos_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPGNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPGNRequest) ProtoMessage() {}
+
+func (x *ExportPGNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPGNRequest.ProtoReflect.Descriptor instead.
+func (*ExportPGNRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportPGNRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type ExportPGNResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pgn           string                 `protobuf:"bytes,1,opt,name=pgn,proto3" json:"pgn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPGNResponse) Reset() {
+	*x = ExportPGNResponse{}
+	mi := &file_game_protos_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPGNResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPGNResponse) ProtoMessage() {}
+
+func (x *ExportPGNResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPGNResponse.ProtoReflect.Descriptor instead.
+func (*ExportPGNResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportPGNResponse) GetPgn() string {
+	if x != nil {
+		return x.Pgn
+	}
+	return ""
+}
+
+type ImportPGNRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // the player importing the games
+	Pgn           string                 `protobuf:"bytes,2,opt,name=pgn,proto3" json:"pgn,omitempty"`                           // one or more games separated by blank lines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPGNRequest) Reset() {
+	*x = ImportPGNRequest{}
+	mi := &file_game_protos_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPGNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPGNRequest) ProtoMessage() {}
+
+func (x *ImportPGNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPGNRequest.ProtoReflect.Descriptor instead.
+func (*ImportPGNRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportPGNRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ImportPGNRequest) GetPgn() string {
+	if x != nil {
+		return x.Pgn
+	}
+	return ""
+}
+
+type ImportPGNResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameIds       []string               `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"` // in the order of the games in the PGN
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPGNResponse) Reset() {
+	*x = ImportPGNResponse{}
+	mi := &file_game_protos_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPGNResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPGNResponse) ProtoMessage() {}
+
+func (x *ImportPGNResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPGNResponse.ProtoReflect.Descriptor instead.
+func (*ImportPGNResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportPGNResponse) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type ExportPlayerGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=game.ExportFormat" json:"format,omitempty"`
+	OldestFirst   bool                   `protobuf:"varint,3,opt,name=oldest_first,json=oldestFirst,proto3" json:"oldest_first,omitempty"` // the newest games come first if not set
+	SinceMs       int64                  `protobuf:"varint,4,opt,name=since_ms,json=sinceMs,proto3" json:"since_ms,omitempty"`             // optional, only games started at or after this unix millisecond
+	UntilMs       int64                  `protobuf:"varint,5,opt,name=until_ms,json=untilMs,proto3" json:"until_ms,omitempty"`             // optional, only games started before this unix millisecond
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPlayerGamesRequest) Reset() {
+	*x = ExportPlayerGamesRequest{}
+	mi := &file_game_protos_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPlayerGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPlayerGamesRequest) ProtoMessage() {}
+
+func (x *ExportPlayerGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPlayerGamesRequest.ProtoReflect.Descriptor instead.
+func (*ExportPlayerGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportPlayerGamesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ExportPlayerGamesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_PGN
+}
+
+func (x *ExportPlayerGamesRequest) GetOldestFirst() bool {
+	if x != nil {
+		return x.OldestFirst
+	}
+	return false
+}
+
+func (x *ExportPlayerGamesRequest) GetSinceMs() int64 {
+	if x != nil {
+		return x.SinceMs
+	}
+	return 0
+}
+
+func (x *ExportPlayerGamesRequest) GetUntilMs() int64 {
+	if x != nil {
+		return x.UntilMs
+	}
+	return 0
+}
+
+type ExportedGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // the game in the requested format, a PGN ends with a blank line and a JSON object with a newline
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedGame) Reset() {
+	*x = ExportedGame{}
+	mi := &file_game_protos_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedGame) ProtoMessage() {}
+
+func (x *ExportedGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedGame.ProtoReflect.Descriptor instead.
+func (*ExportedGame) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportedGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ExportedGame) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type PlayGameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*PlayGameRequest_Join
+	//	*PlayGameRequest_Move
+	//	*PlayGameRequest_Resign
+	//	*PlayGameRequest_OfferDraw
+	//	*PlayGameRequest_DrawResponse
+	//	*PlayGameRequest_Abort
+	//	*PlayGameRequest_RequestTakeback
+	//	*PlayGameRequest_TakebackResponse
+	Action        isPlayGameRequest_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayGameRequest) Reset() {
+	*x = PlayGameRequest{}
+	mi := &file_game_protos_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayGameRequest) ProtoMessage() {}
+
+func (x *PlayGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayGameRequest.ProtoReflect.Descriptor instead.
+func (*PlayGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PlayGameRequest) GetAction() isPlayGameRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetJoin() *JoinGame {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetMove() *Move {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetResign() *Resign {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_Resign); ok {
+			return x.Resign
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetOfferDraw() *OfferDraw {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_OfferDraw); ok {
+			return x.OfferDraw
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetDrawResponse() *DrawResponse {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_DrawResponse); ok {
+			return x.DrawResponse
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetAbort() *Abort {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_Abort); ok {
+			return x.Abort
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetRequestTakeback() *RequestTakeback {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_RequestTakeback); ok {
+			return x.RequestTakeback
+		}
+	}
+	return nil
+}
+
+func (x *PlayGameRequest) GetTakebackResponse() *TakebackResponse {
+	if x != nil {
+		if x, ok := x.Action.(*PlayGameRequest_TakebackResponse); ok {
+			return x.TakebackResponse
+		}
+	}
+	return nil
+}
+
+type isPlayGameRequest_Action interface {
+	isPlayGameRequest_Action()
+}
+
+type PlayGameRequest_Join struct {
+	Join *JoinGame `protobuf:"bytes,1,opt,name=join,proto3,oneof"` // must be the first message of the stream
+}
+
+type PlayGameRequest_Move struct {
+	Move *Move `protobuf:"bytes,2,opt,name=move,proto3,oneof"`
+}
+
+type PlayGameRequest_Resign struct {
+	Resign *Resign `protobuf:"bytes,3,opt,name=resign,proto3,oneof"`
+}
+
+type PlayGameRequest_OfferDraw struct {
+	OfferDraw *OfferDraw `protobuf:"bytes,4,opt,name=offer_draw,json=offerDraw,proto3,oneof"`
+}
+
+type PlayGameRequest_DrawResponse struct {
+	DrawResponse *DrawResponse `protobuf:"bytes,5,opt,name=draw_response,json=drawResponse,proto3,oneof"`
+}
+
+type PlayGameRequest_Abort struct {
+	Abort *Abort `protobuf:"bytes,6,opt,name=abort,proto3,oneof"`
+}
+
+type PlayGameRequest_RequestTakeback struct {
+	RequestTakeback *RequestTakeback `protobuf:"bytes,7,opt,name=request_takeback,json=requestTakeback,proto3,oneof"`
+}
+
+type PlayGameRequest_TakebackResponse struct {
+	TakebackResponse *TakebackResponse `protobuf:"bytes,8,opt,name=takeback_response,json=takebackResponse,proto3,oneof"`
+}
+
+func (*PlayGameRequest_Join) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_Move) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_Resign) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_OfferDraw) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_DrawResponse) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_Abort) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_RequestTakeback) isPlayGameRequest_Action() {}
+
+func (*PlayGameRequest_TakebackResponse) isPlayGameRequest_Action() {}
+
+type JoinGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGame) Reset() {
+	*x = JoinGame{}
+	mi := &file_game_protos_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGame) ProtoMessage() {}
+
+func (x *JoinGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGame.ProtoReflect.Descriptor instead.
+func (*JoinGame) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JoinGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGame) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type Resign struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resign) Reset() {
+	*x = Resign{}
+	mi := &file_game_protos_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resign) ProtoMessage() {}
+
+func (x *Resign) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resign.ProtoReflect.Descriptor instead.
+func (*Resign) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{44}
+}
+
+type OfferDraw struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferDraw) Reset() {
+	*x = OfferDraw{}
+	mi := &file_game_protos_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferDraw) ProtoMessage() {}
+
+func (x *OfferDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferDraw.ProtoReflect.Descriptor instead.
+func (*OfferDraw) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{45}
+}
+
+type DrawResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accept        bool                   `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawResponse) Reset() {
+	*x = DrawResponse{}
+	mi := &file_game_protos_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawResponse) ProtoMessage() {}
+
+func (x *DrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawResponse.ProtoReflect.Descriptor instead.
+func (*DrawResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DrawResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type Abort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Abort) Reset() {
+	*x = Abort{}
+	mi := &file_game_protos_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Abort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Abort) ProtoMessage() {}
+
+func (x *Abort) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Abort.ProtoReflect.Descriptor instead.
+func (*Abort) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{47}
+}
+
+type RequestTakeback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullMove      bool                   `protobuf:"varint,1,opt,name=full_move,json=fullMove,proto3" json:"full_move,omitempty"` // undo the last two moves instead of the requester's last move
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestTakeback) Reset() {
+	*x = RequestTakeback{}
+	mi := &file_game_protos_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestTakeback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTakeback) ProtoMessage() {}
+
+func (x *RequestTakeback) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTakeback.ProtoReflect.Descriptor instead.
+func (*RequestTakeback) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RequestTakeback) GetFullMove() bool {
+	if x != nil {
+		return x.FullMove
+	}
+	return false
+}
+
+type TakebackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accept        bool                   `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakebackResponse) Reset() {
+	*x = TakebackResponse{}
+	mi := &file_game_protos_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakebackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakebackResponse) ProtoMessage() {}
+
+func (x *TakebackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakebackResponse.ProtoReflect.Descriptor instead.
+func (*TakebackResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TakebackResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type GameActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameActionRequest) Reset() {
+	*x = GameActionRequest{}
+	mi := &file_game_protos_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameActionRequest) ProtoMessage() {}
+
+func (x *GameActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameActionRequest.ProtoReflect.Descriptor instead.
+func (*GameActionRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GameActionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameActionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RespondDrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondDrawRequest) Reset() {
+	*x = RespondDrawRequest{}
+	mi := &file_game_protos_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondDrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondDrawRequest) ProtoMessage() {}
+
+func (x *RespondDrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondDrawRequest.ProtoReflect.Descriptor instead.
+func (*RespondDrawRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RespondDrawRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RespondDrawRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RespondDrawRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type TakebackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	FullMove      bool                   `protobuf:"varint,3,opt,name=full_move,json=fullMove,proto3" json:"full_move,omitempty"` // undo the last two moves instead of the requester's last move
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakebackRequest) Reset() {
+	*x = TakebackRequest{}
+	mi := &file_game_protos_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakebackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakebackRequest) ProtoMessage() {}
+
+func (x *TakebackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakebackRequest.ProtoReflect.Descriptor instead.
+func (*TakebackRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TakebackRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TakebackRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TakebackRequest) GetFullMove() bool {
+	if x != nil {
+		return x.FullMove
+	}
+	return false
+}
+
+type RespondTakebackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondTakebackRequest) Reset() {
+	*x = RespondTakebackRequest{}
+	mi := &file_game_protos_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondTakebackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondTakebackRequest) ProtoMessage() {}
+
+func (x *RespondTakebackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondTakebackRequest.ProtoReflect.Descriptor instead.
+func (*RespondTakebackRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RespondTakebackRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RespondTakebackRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RespondTakebackRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpponentAway  bool                   `protobuf:"varint,1,opt,name=opponent_away,json=opponentAway,proto3" json:"opponent_away,omitempty"`
+	Spectators    int32                  `protobuf:"varint,2,opt,name=spectators,proto3" json:"spectators,omitempty"` // number of spectators watching the game
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_game_protos_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HeartbeatResponse) GetOpponentAway() bool {
+	if x != nil {
+		return x.OpponentAway
+	}
+	return false
+}
+
+func (x *HeartbeatResponse) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+type ClaimAbandonmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Draw          bool                   `protobuf:"varint,3,opt,name=draw,proto3" json:"draw,omitempty"` // call it a draw instead of claiming the win
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimAbandonmentRequest) Reset() {
+	*x = ClaimAbandonmentRequest{}
+	mi := &file_game_protos_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimAbandonmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAbandonmentRequest) ProtoMessage() {}
+
+func (x *ClaimAbandonmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAbandonmentRequest.ProtoReflect.Descriptor instead.
+func (*ClaimAbandonmentRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClaimAbandonmentRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ClaimAbandonmentRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ClaimAbandonmentRequest) GetDraw() bool {
+	if x != nil {
+		return x.Draw
+	}
+	return false
+}
+
+type GameActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       GameOutcome            `protobuf:"varint,1,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"` // ONGOING unless the action has ended the game
+	Termination   Termination            `protobuf:"varint,2,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameActionResponse) Reset() {
+	*x = GameActionResponse{}
+	mi := &file_game_protos_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameActionResponse) ProtoMessage() {}
+
+func (x *GameActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameActionResponse.ProtoReflect.Descriptor instead.
+func (*GameActionResponse) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GameActionResponse) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_ONGOING
+}
+
+func (x *GameActionResponse) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+type GameEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*GameEvent_MoveMade
+	//	*GameEvent_DrawOffered
+	//	*GameEvent_DrawDeclined
+	//	*GameEvent_Result
+	//	*GameEvent_Rejected
+	//	*GameEvent_TakebackRequested
+	//	*GameEvent_TakebackDeclined
+	//	*GameEvent_TakenBack
+	//	*GameEvent_PlayerAway
+	//	*GameEvent_PlayerReturned
+	//	*GameEvent_Snapshot
+	//	*GameEvent_ClockTick
+	//	*GameEvent_Spectators
+	Event         isGameEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	mi := &file_game_protos_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GameEvent) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameEvent) GetEvent() isGameEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GameEvent) GetMoveMade() *MoveMade {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_MoveMade); ok {
+			return x.MoveMade
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetDrawOffered() *DrawOffered {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_DrawOffered); ok {
+			return x.DrawOffered
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetDrawDeclined() *DrawDeclined {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_DrawDeclined); ok {
+			return x.DrawDeclined
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetResult() *GameResult {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetRejected() *ActionRejected {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_Rejected); ok {
+			return x.Rejected
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTakebackRequested() *TakebackRequested {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_TakebackRequested); ok {
+			return x.TakebackRequested
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTakebackDeclined() *TakebackDeclined {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_TakebackDeclined); ok {
+			return x.TakebackDeclined
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetTakenBack() *TakenBack {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_TakenBack); ok {
+			return x.TakenBack
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerAway() *PlayerAway {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PlayerAway); ok {
+			return x.PlayerAway
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerReturned() *PlayerReturned {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_PlayerReturned); ok {
+			return x.PlayerReturned
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetSnapshot() *GameSnapshot {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetClockTick() *ClockTick {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_ClockTick); ok {
+			return x.ClockTick
+		}
+	}
+	return nil
+}
+
+func (x *GameEvent) GetSpectators() *SpectatorCount {
+	if x != nil {
+		if x, ok := x.Event.(*GameEvent_Spectators); ok {
+			return x.Spectators
+		}
+	}
+	return nil
+}
+
+type isGameEvent_Event interface {
+	isGameEvent_Event()
+}
+
+type GameEvent_MoveMade struct {
+	MoveMade *MoveMade `protobuf:"bytes,2,opt,name=move_made,json=moveMade,proto3,oneof"`
+}
+
+type GameEvent_DrawOffered struct {
+	DrawOffered *DrawOffered `protobuf:"bytes,3,opt,name=draw_offered,json=drawOffered,proto3,oneof"`
+}
+
+type GameEvent_DrawDeclined struct {
+	DrawDeclined *DrawDeclined `protobuf:"bytes,4,opt,name=draw_declined,json=drawDeclined,proto3,oneof"`
+}
+
+type GameEvent_Result struct {
+	Result *GameResult `protobuf:"bytes,5,opt,name=result,proto3,oneof"`
+}
+
+type GameEvent_Rejected struct {
+	Rejected *ActionRejected `protobuf:"bytes,6,opt,name=rejected,proto3,oneof"` // only sent to the player whose action was refused
+}
+
+type GameEvent_TakebackRequested struct {
+	TakebackRequested *TakebackRequested `protobuf:"bytes,7,opt,name=takeback_requested,json=takebackRequested,proto3,oneof"`
+}
+
+type GameEvent_TakebackDeclined struct {
+	TakebackDeclined *TakebackDeclined `protobuf:"bytes,8,opt,name=takeback_declined,json=takebackDeclined,proto3,oneof"`
+}
+
+type GameEvent_TakenBack struct {
+	TakenBack *TakenBack `protobuf:"bytes,9,opt,name=taken_back,json=takenBack,proto3,oneof"`
+}
+
+type GameEvent_PlayerAway struct {
+	PlayerAway *PlayerAway `protobuf:"bytes,10,opt,name=player_away,json=playerAway,proto3,oneof"`
+}
+
+type GameEvent_PlayerReturned struct {
+	PlayerReturned *PlayerReturned `protobuf:"bytes,11,opt,name=player_returned,json=playerReturned,proto3,oneof"`
+}
+
+type GameEvent_Snapshot struct {
+	Snapshot *GameSnapshot `protobuf:"bytes,12,opt,name=snapshot,proto3,oneof"` // only sent to spectators, as the first event
+}
+
+type GameEvent_ClockTick struct {
+	ClockTick *ClockTick `protobuf:"bytes,13,opt,name=clock_tick,json=clockTick,proto3,oneof"` // only sent to spectators
+}
+
+type GameEvent_Spectators struct {
+	Spectators *SpectatorCount `protobuf:"bytes,14,opt,name=spectators,proto3,oneof"`
+}
+
+func (*GameEvent_MoveMade) isGameEvent_Event() {}
+
+func (*GameEvent_DrawOffered) isGameEvent_Event() {}
+
+func (*GameEvent_DrawDeclined) isGameEvent_Event() {}
+
+func (*GameEvent_Result) isGameEvent_Event() {}
+
+func (*GameEvent_Rejected) isGameEvent_Event() {}
+
+func (*GameEvent_TakebackRequested) isGameEvent_Event() {}
+
+func (*GameEvent_TakebackDeclined) isGameEvent_Event() {}
+
+func (*GameEvent_TakenBack) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerAway) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerReturned) isGameEvent_Event() {}
+
+func (*GameEvent_Snapshot) isGameEvent_Event() {}
+
+func (*GameEvent_ClockTick) isGameEvent_Event() {}
+
+func (*GameEvent_Spectators) isGameEvent_Event() {}
+
+type WatchGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	mi := &file_game_protos_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRequest) ProtoMessage() {}
+
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WatchGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GameSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fen           string                 `protobuf:"bytes,1,opt,name=fen,proto3" json:"fen,omitempty"`
+	Moves         []string               `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"` // moves played so far in SAN, taken back moves are left out
+	WhiteId       string                 `protobuf:"bytes,3,opt,name=white_id,json=whiteId,proto3" json:"white_id,omitempty"`
+	BlackId       string                 `protobuf:"bytes,4,opt,name=black_id,json=blackId,proto3" json:"black_id,omitempty"`
+	WhiteClockMs  int64                  `protobuf:"varint,5,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"` // remaining times as of the snapshot
+	BlackClockMs  int64                  `protobuf:"varint,6,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	IncrementMs   int64                  `protobuf:"varint,7,opt,name=increment_ms,json=incrementMs,proto3" json:"increment_ms,omitempty"`
+	DelayMs       int64                  `protobuf:"varint,8,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	Turn          Color                  `protobuf:"varint,9,opt,name=turn,proto3,enum=game.Color" json:"turn,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Outcome       GameOutcome            `protobuf:"varint,11,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"`
+	Termination   Termination            `protobuf:"varint,12,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
+	DrawOfferedBy string                 `protobuf:"bytes,13,opt,name=draw_offered_by,json=drawOfferedBy,proto3" json:"draw_offered_by,omitempty"` // player with an open draw offer, empty if there is none
+	Rated         bool                   `protobuf:"varint,14,opt,name=rated,proto3" json:"rated,omitempty"`
+	Spectators    int32                  `protobuf:"varint,15,opt,name=spectators,proto3" json:"spectators,omitempty"`
+	Version       int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"` // version of the game the snapshot was taken at, events up to it are part of the snapshot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSnapshot) Reset() {
+	*x = GameSnapshot{}
+	mi := &file_game_protos_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSnapshot) ProtoMessage() {}
+
+func (x *GameSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSnapshot.ProtoReflect.Descriptor instead.
+func (*GameSnapshot) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GameSnapshot) GetFen() string {
+	if x != nil {
+		return x.Fen
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetMoves() []string {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *GameSnapshot) GetWhiteId() string {
+	if x != nil {
+		return x.WhiteId
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetBlackId() string {
+	if x != nil {
+		return x.BlackId
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetWhiteClockMs() int64 {
+	if x != nil {
+		return x.WhiteClockMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetIncrementMs() int64 {
+	if x != nil {
+		return x.IncrementMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetDelayMs() int64 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetTurn() Color {
+	if x != nil {
+		return x.Turn
+	}
+	return Color_NO_COLOR
+}
+
+func (x *GameSnapshot) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_ONGOING
+}
+
+func (x *GameSnapshot) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+func (x *GameSnapshot) GetDrawOfferedBy() string {
+	if x != nil {
+		return x.DrawOfferedBy
+	}
+	return ""
+}
+
+func (x *GameSnapshot) GetRated() bool {
+	if x != nil {
+		return x.Rated
+	}
+	return false
+}
+
+func (x *GameSnapshot) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+func (x *GameSnapshot) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ClockTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WhiteClockMs  int64                  `protobuf:"varint,1,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"`
+	BlackClockMs  int64                  `protobuf:"varint,2,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClockTick) Reset() {
+	*x = ClockTick{}
+	mi := &file_game_protos_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClockTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockTick) ProtoMessage() {}
+
+func (x *ClockTick) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockTick.ProtoReflect.Descriptor instead.
+func (*ClockTick) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ClockTick) GetWhiteClockMs() int64 {
+	if x != nil {
+		return x.WhiteClockMs
+	}
+	return 0
+}
+
+func (x *ClockTick) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
+}
+
+type SpectatorCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorCount) Reset() {
+	*x = SpectatorCount{}
+	mi := &file_game_protos_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorCount) ProtoMessage() {}
+
+func (x *SpectatorCount) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorCount.ProtoReflect.Descriptor instead.
+func (*SpectatorCount) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SpectatorCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MoveMade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Move          *Move                  `protobuf:"bytes,2,opt,name=move,proto3" json:"move,omitempty"`
+	IsCheck       bool                   `protobuf:"varint,3,opt,name=is_check,json=isCheck,proto3" json:"is_check,omitempty"`
+	IsCheckmate   bool                   `protobuf:"varint,4,opt,name=is_checkmate,json=isCheckmate,proto3" json:"is_checkmate,omitempty"`
+	WhiteClockMs  int64                  `protobuf:"varint,5,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"` // remaining times after the move, the clock of the side to move runs from now on
+	BlackClockMs  int64                  `protobuf:"varint,6,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"` // version of the game after the move
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveMade) Reset() {
+	*x = MoveMade{}
+	mi := &file_game_protos_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveMade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveMade) ProtoMessage() {}
+
+func (x *MoveMade) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveMade.ProtoReflect.Descriptor instead.
+func (*MoveMade) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MoveMade) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MoveMade) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *MoveMade) GetIsCheck() bool {
+	if x != nil {
+		return x.IsCheck
+	}
+	return false
+}
+
+func (x *MoveMade) GetIsCheckmate() bool {
+	if x != nil {
+		return x.IsCheckmate
+	}
+	return false
 }
 
-func (PieceType) Type() protoreflect.EnumType {
-	return &file_game_protos_proto_enumTypes[0]
+func (x *MoveMade) GetWhiteClockMs() int64 {
+	if x != nil {
+		return x.WhiteClockMs
+	}
+	return 0
 }
 
-func (x PieceType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *MoveMade) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
 }
 
-// Deprecated: Use PieceType.Descriptor instead.
-func (PieceType) EnumDescriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{0}
+func (x *MoveMade) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-type Move struct {
+type DrawOffered struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MoveFrom      string                 `protobuf:"bytes,1,opt,name=move_from,json=moveFrom,proto3" json:"move_from,omitempty"`
-	MoveTo        string                 `protobuf:"bytes,2,opt,name=move_to,json=moveTo,proto3" json:"move_to,omitempty"`
-	IsWhite       bool                   `protobuf:"varint,3,opt,name=is_white,json=isWhite,proto3" json:"is_white,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Move) Reset() {
-	*x = Move{}
-	mi := &file_game_protos_proto_msgTypes[0]
+func (x *DrawOffered) Reset() {
+	*x = DrawOffered{}
+	mi := &file_game_protos_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Move) String() string {
+func (x *DrawOffered) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Move) ProtoMessage() {}
+func (*DrawOffered) ProtoMessage() {}
 
-func (x *Move) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[0]
+func (x *DrawOffered) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,56 +4643,85 @@ func (x *Move) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Move.ProtoReflect.Descriptor instead.
-func (*Move) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DrawOffered.ProtoReflect.Descriptor instead.
+func (*DrawOffered) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Move) GetMoveFrom() string {
+func (x *DrawOffered) GetPlayerId() string {
 	if x != nil {
-		return x.MoveFrom
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *Move) GetMoveTo() string {
+type DrawDeclined struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawDeclined) Reset() {
+	*x = DrawDeclined{}
+	mi := &file_game_protos_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawDeclined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawDeclined) ProtoMessage() {}
+
+func (x *DrawDeclined) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[64]
 	if x != nil {
-		return x.MoveTo
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Move) GetIsWhite() bool {
+// Deprecated: Use DrawDeclined.ProtoReflect.Descriptor instead.
+func (*DrawDeclined) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DrawDeclined) GetPlayerId() string {
 	if x != nil {
-		return x.IsWhite
+		return x.PlayerId
 	}
-	return false
+	return ""
 }
 
-type CreateGameRequest struct {
+type TakebackRequested struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerRank    int32                  `protobuf:"varint,2,opt,name=player_rank,json=playerRank,proto3" json:"player_rank,omitempty"`
-	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Plies         int32                  `protobuf:"varint,2,opt,name=plies,proto3" json:"plies,omitempty"` // number of moves that would be undone
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameRequest) Reset() {
-	*x = CreateGameRequest{}
-	mi := &file_game_protos_proto_msgTypes[1]
+func (x *TakebackRequested) Reset() {
+	*x = TakebackRequested{}
+	mi := &file_game_protos_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameRequest) String() string {
+func (x *TakebackRequested) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameRequest) ProtoMessage() {}
+func (*TakebackRequested) ProtoMessage() {}
 
-func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[1]
+func (x *TakebackRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,56 +4732,95 @@ func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameRequest) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TakebackRequested.ProtoReflect.Descriptor instead.
+func (*TakebackRequested) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreateGameRequest) GetPlayerId() string {
+func (x *TakebackRequested) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *CreateGameRequest) GetPlayerRank() int32 {
+func (x *TakebackRequested) GetPlies() int32 {
 	if x != nil {
-		return x.PlayerRank
+		return x.Plies
 	}
 	return 0
 }
 
-func (x *CreateGameRequest) GetDuration() int32 {
+type TakebackDeclined struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakebackDeclined) Reset() {
+	*x = TakebackDeclined{}
+	mi := &file_game_protos_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakebackDeclined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakebackDeclined) ProtoMessage() {}
+
+func (x *TakebackDeclined) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[66]
 	if x != nil {
-		return x.Duration
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type MakeMoveRequest struct {
+// Deprecated: Use TakebackDeclined.ProtoReflect.Descriptor instead.
+func (*TakebackDeclined) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TakebackDeclined) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type TakenBack struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Move          *Move                  `protobuf:"bytes,3,opt,name=move,proto3" json:"move,omitempty"`
+	Plies         int32                  `protobuf:"varint,1,opt,name=plies,proto3" json:"plies,omitempty"`
+	Fen           string                 `protobuf:"bytes,2,opt,name=fen,proto3" json:"fen,omitempty"` // position after the takeback
+	WhiteClockMs  int64                  `protobuf:"varint,3,opt,name=white_clock_ms,json=whiteClockMs,proto3" json:"white_clock_ms,omitempty"`
+	BlackClockMs  int64                  `protobuf:"varint,4,opt,name=black_clock_ms,json=blackClockMs,proto3" json:"black_clock_ms,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // version of the game after the takeback
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MakeMoveRequest) Reset() {
-	*x = MakeMoveRequest{}
-	mi := &file_game_protos_proto_msgTypes[2]
+func (x *TakenBack) Reset() {
+	*x = TakenBack{}
+	mi := &file_game_protos_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MakeMoveRequest) String() string {
+func (x *TakenBack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeMoveRequest) ProtoMessage() {}
+func (*TakenBack) ProtoMessage() {}
 
-func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[2]
+func (x *TakenBack) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,57 +4831,69 @@ func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeMoveRequest.ProtoReflect.Descriptor instead.
-func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TakenBack.ProtoReflect.Descriptor instead.
+func (*TakenBack) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *MakeMoveRequest) GetGameId() string {
+func (x *TakenBack) GetPlies() int32 {
 	if x != nil {
-		return x.GameId
+		return x.Plies
 	}
-	return ""
+	return 0
 }
 
-func (x *MakeMoveRequest) GetPlayerId() string {
+func (x *TakenBack) GetFen() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Fen
 	}
 	return ""
 }
 
-func (x *MakeMoveRequest) GetMove() *Move {
+func (x *TakenBack) GetWhiteClockMs() int64 {
 	if x != nil {
-		return x.Move
+		return x.WhiteClockMs
 	}
-	return nil
+	return 0
 }
 
-type MakeMoveResponse struct {
+func (x *TakenBack) GetBlackClockMs() int64 {
+	if x != nil {
+		return x.BlackClockMs
+	}
+	return 0
+}
+
+func (x *TakenBack) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PlayerAway struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	IsCheck       bool                   `protobuf:"varint,3,opt,name=is_check,json=isCheck,proto3" json:"is_check,omitempty"`             // this is needed in frontend, if the move is a check, then move sound will be different than a basic move
-	IsCheckmate   bool                   `protobuf:"varint,4,opt,name=is_checkmate,json=isCheckmate,proto3" json:"is_checkmate,omitempty"` // determine if after this move the game is finished with checkmate
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ForfeitInMs   int64                  `protobuf:"varint,2,opt,name=forfeit_in_ms,json=forfeitInMs,proto3" json:"forfeit_in_ms,omitempty"` // the game is forfeited if the player does not come back in time, until then the opponent may claim it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MakeMoveResponse) Reset() {
-	*x = MakeMoveResponse{}
-	mi := &file_game_protos_proto_msgTypes[3]
+func (x *PlayerAway) Reset() {
+	*x = PlayerAway{}
+	mi := &file_game_protos_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MakeMoveResponse) String() string {
+func (x *PlayerAway) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeMoveResponse) ProtoMessage() {}
+func (*PlayerAway) ProtoMessage() {}
 
-func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[3]
+func (x *PlayerAway) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,61 +4904,92 @@ func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeMoveResponse.ProtoReflect.Descriptor instead.
-func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PlayerAway.ProtoReflect.Descriptor instead.
+func (*PlayerAway) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *MakeMoveResponse) GetSuccess() bool {
+func (x *PlayerAway) GetPlayerId() string {
 	if x != nil {
-		return x.Success
+		return x.PlayerId
 	}
-	return false
+	return ""
 }
 
-func (x *MakeMoveResponse) GetMessage() string {
+func (x *PlayerAway) GetForfeitInMs() int64 {
 	if x != nil {
-		return x.Message
+		return x.ForfeitInMs
 	}
-	return ""
+	return 0
 }
 
-func (x *MakeMoveResponse) GetIsCheck() bool {
+type PlayerReturned struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerReturned) Reset() {
+	*x = PlayerReturned{}
+	mi := &file_game_protos_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerReturned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerReturned) ProtoMessage() {}
+
+func (x *PlayerReturned) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[69]
 	if x != nil {
-		return x.IsCheck
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *MakeMoveResponse) GetIsCheckmate() bool {
+// Deprecated: Use PlayerReturned.ProtoReflect.Descriptor instead.
+func (*PlayerReturned) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PlayerReturned) GetPlayerId() string {
 	if x != nil {
-		return x.IsCheckmate
+		return x.PlayerId
 	}
-	return false
+	return ""
 }
 
-type GetGameStatsRequest struct {
+type GameResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Outcome       GameOutcome            `protobuf:"varint,1,opt,name=outcome,proto3,enum=game.GameOutcome" json:"outcome,omitempty"`
+	Termination   Termination            `protobuf:"varint,2,opt,name=termination,proto3,enum=game.Termination" json:"termination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStatsRequest) Reset() {
-	*x = GetGameStatsRequest{}
-	mi := &file_game_protos_proto_msgTypes[4]
+func (x *GameResult) Reset() {
+	*x = GameResult{}
+	mi := &file_game_protos_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStatsRequest) String() string {
+func (x *GameResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStatsRequest) ProtoMessage() {}
+func (*GameResult) ProtoMessage() {}
 
-func (x *GetGameStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[4]
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,40 +5000,47 @@ func (x *GetGameStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetGameStatsRequest) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetGameStatsRequest) GetGameId() string {
+func (x *GameResult) GetOutcome() GameOutcome {
 	if x != nil {
-		return x.GameId
+		return x.Outcome
 	}
-	return ""
+	return GameOutcome_ONGOING
 }
 
-type GetGameStatsResponse struct {
+func (x *GameResult) GetTermination() Termination {
+	if x != nil {
+		return x.Termination
+	}
+	return Termination_NO_TERMINATION
+}
+
+type ActionRejected struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Moves         []*Move                `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStatsResponse) Reset() {
-	*x = GetGameStatsResponse{}
-	mi := &file_game_protos_proto_msgTypes[5]
+func (x *ActionRejected) Reset() {
+	*x = ActionRejected{}
+	mi := &file_game_protos_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStatsResponse) String() string {
+func (x *ActionRejected) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStatsResponse) ProtoMessage() {}
+func (*ActionRejected) ProtoMessage() {}
 
-func (x *GetGameStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[5]
+func (x *ActionRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_game_protos_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,16 +5051,16 @@ func (x *GetGameStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetGameStatsResponse) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ActionRejected.ProtoReflect.Descriptor instead.
+func (*ActionRejected) Descriptor() ([]byte, []int) {
+	return file_game_protos_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetGameStatsResponse) GetMoves() []*Move {
+func (x *ActionRejected) GetReason() string {
 	if x != nil {
-		return x.Moves
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
 type Piece struct {
@@ -428,7 +5075,7 @@ type Piece struct {
 
 func (x *Piece) Reset() {
 	*x = Piece{}
-	mi := &file_game_protos_proto_msgTypes[6]
+	mi := &file_game_protos_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +5087,7 @@ func (x *Piece) String() string {
 func (*Piece) ProtoMessage() {}
 
 func (x *Piece) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[6]
+	mi := &file_game_protos_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +5100,7 @@ func (x *Piece) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Piece.ProtoReflect.Descriptor instead.
 func (*Piece) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{6}
+	return file_game_protos_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *Piece) GetType() PieceType {
@@ -495,7 +5142,7 @@ type Game struct {
 
 func (x *Game) Reset() {
 	*x = Game{}
-	mi := &file_game_protos_proto_msgTypes[7]
+	mi := &file_game_protos_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +5154,7 @@ func (x *Game) String() string {
 func (*Game) ProtoMessage() {}
 
 func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_game_protos_proto_msgTypes[7]
+	mi := &file_game_protos_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +5167,7 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Game.ProtoReflect.Descriptor instead.
 func (*Game) Descriptor() ([]byte, []int) {
-	return file_game_protos_proto_rawDescGZIP(), []int{7}
+	return file_game_protos_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *Game) GetGameId() string {
@@ -548,77 +5195,744 @@ var File_game_protos_proto protoreflect.FileDescriptor
 
 var file_game_protos_proto_rawDesc = string([]byte{
 	0x0a, 0x11, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6d,
-	0x6f, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f,
-	0x76, 0x65, 0x54, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x57, 0x68, 0x69, 0x74, 0x65, 0x22,
-	0x6d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61,
-	0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67,
-	0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x4d, 0x61, 0x6b, 0x65,
-	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x2e,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x38,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76,
-	0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x05, 0x50, 0x69, 0x65, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x57, 0x68, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x04, 0x47, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52,
-	0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x2a, 0x4c, 0x0a, 0x09, 0x50, 0x69, 0x65, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x52, 0x4f, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x4e, 0x49, 0x47, 0x48,
-	0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x53, 0x48, 0x4f, 0x50, 0x10, 0x03, 0x12,
-	0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x45, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49,
-	0x4e, 0x47, 0x10, 0x05, 0x32, 0xce, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
-	0x12, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x04, 0x4d, 0x6f,
+	0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x69,
+	0x65, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x61, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x63, 0x69,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x63, 0x69, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x73,
+	0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x73, 0x75, 0x61,
+	0x6c, 0x12, 0x34, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x14, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x22, 0xb7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xf1, 0x01, 0x0a, 0x09, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x4d, 0x73, 0x22, 0x58,
+	0x0a, 0x16, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22,
+	0x1a, 0x0a, 0x18, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x16,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x22, 0x30, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0xbd, 0x02, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x29, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x24, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x4c, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x66, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x09, 0x0a, 0x07, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0x67, 0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x10, 0x4d,
 	0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19,
-	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x63, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x63, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x61, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x68, 0x69, 0x74, 0x65,
+	0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x77, 0x68, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4d, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x22, 0x29, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x87, 0x05, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x77, 0x68, 0x69, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x77, 0x68, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x66, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
+	0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66, 0x66, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x47,
+	0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x61, 0x73,
+	0x65, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4d,
+	0x73, 0x22, 0x6b, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x63, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x63, 0x69, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x61,
+	0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41,
+	0x74, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x22, 0xcb,
+	0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x4d, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa3, 0x04, 0x0a, 0x0b,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x68, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f,
+	0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x68, 0x69, 0x74, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x68, 0x69,
+	0x74, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x66,
+	0x66, 0x22, 0x2b, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x25,
+	0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x67, 0x6e, 0x22, 0x41, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x47, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x67, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x67, 0x6e, 0x22, 0x2e, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x4d, 0x73, 0x22, 0x3b, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xa8, 0x03, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x20,
+	0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52,
+	0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x72,
+	0x61, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x45,
+	0x0a, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x10, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x40, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x08, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x0b, 0x0a, 0x09, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x22, 0x26, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x22, 0x07, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x22, 0x2e, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x54, 0x61, 0x6b,
+	0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x62, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x44, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x22, 0x64, 0x0a, 0x0f, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x22, 0x58, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x77, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x41, 0x77, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x17,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x61,
+	0x77, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x06, 0x0a, 0x09, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x2d, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4d,
+	0x61, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x61, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x0c, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x44, 0x72, 0x61,
+	0x77, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x72, 0x61, 0x77,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32,
+	0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x74, 0x61, 0x6b, 0x65, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x11,
+	0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54,
+	0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x10, 0x74, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54,
+	0x61, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x6b, 0x65,
+	0x6e, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x61, 0x77, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x77, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x77, 0x61, 0x79, 0x12, 0x3f, 0x0a, 0x0f, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x30, 0x0a,
+	0x0a, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x12,
+	0x36, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x2b, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x89, 0x04,
+	0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x68, 0x69, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x68, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x72, 0x61, 0x77,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x09, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x08, 0x4d,
+	0x6f, 0x76, 0x65, 0x4d, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6d, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x77,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x77, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x46, 0x0a, 0x11, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x54, 0x61, 0x6b,
+	0x65, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x54,
+	0x61, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x66, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x6e,
+	0x12, 0x24, 0x0a, 0x0e, 0x77, 0x68, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x68, 0x69, 0x74, 0x65, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x5f,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x77, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69,
+	0x74, 0x49, 0x6e, 0x4d, 0x73, 0x22, 0x2d, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x33, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7f,
+	0x0a, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x69, 0x65,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x22,
+	0x5b, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x2a, 0x2b, 0x0a, 0x05,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4c, 0x4f,
+	0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x48, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x2a, 0x3c, 0x0a, 0x0c, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x4e, 0x59,
+	0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x03, 0x2a, 0x23, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x47, 0x4e, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x42, 0x0a, 0x0b,
+	0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f,
+	0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x48, 0x49, 0x54,
+	0x45, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x4c, 0x41, 0x43, 0x4b,
+	0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03,
+	0x2a, 0x8e, 0x02, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x4d, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x41, 0x47, 0x52,
+	0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x54, 0x41, 0x4c,
+	0x45, 0x4d, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x48, 0x52, 0x45, 0x45,
+	0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x45, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x05, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x56, 0x45, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x45,
+	0x50, 0x45, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49,
+	0x46, 0x54, 0x59, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x07, 0x12,
+	0x1a, 0x0a, 0x16, 0x53, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x59, 0x5f, 0x46, 0x49, 0x56, 0x45, 0x5f,
+	0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x49,
+	0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x45,
+	0x52, 0x49, 0x41, 0x4c, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55,
+	0x54, 0x10, 0x0a, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0b,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x0c, 0x2a, 0x4c, 0x0a, 0x09, 0x50, 0x69, 0x65, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x50, 0x41, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x4f, 0x4b,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x42, 0x49, 0x53, 0x48, 0x4f, 0x50, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55,
+	0x45, 0x45, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x32,
+	0xf1, 0x0d, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x15, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x47, 0x4e, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x47, 0x4e, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x47, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x47, 0x4e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x30, 0x01, 0x12,
+	0x38, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x28, 0x01, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x12, 0x17,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x44, 0x72, 0x61, 0x77,
+	0x12, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x44,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x54,
+	0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x61, 0x6b, 0x65, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x30, 0x01, 0x42, 0x14, 0x5a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -633,36 +5947,212 @@ func file_game_protos_proto_rawDescGZIP() []byte {
 	return file_game_protos_proto_rawDescData
 }
 
-var file_game_protos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_game_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_game_protos_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_game_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_game_protos_proto_goTypes = []any{
-	(PieceType)(0),               // 0: game.PieceType
-	(*Move)(nil),                 // 1: game.Move
-	(*CreateGameRequest)(nil),    // 2: game.CreateGameRequest
-	(*MakeMoveRequest)(nil),      // 3: game.MakeMoveRequest
-	(*MakeMoveResponse)(nil),     // 4: game.MakeMoveResponse
-	(*GetGameStatsRequest)(nil),  // 5: game.GetGameStatsRequest
-	(*GetGameStatsResponse)(nil), // 6: game.GetGameStatsResponse
-	(*Piece)(nil),                // 7: game.Piece
-	(*Game)(nil),                 // 8: game.Game
-	(*emptypb.Empty)(nil),        // 9: google.protobuf.Empty
+	(Color)(0),                             // 0: game.Color
+	(PlayerResult)(0),                      // 1: game.PlayerResult
+	(ExportFormat)(0),                      // 2: game.ExportFormat
+	(GameOutcome)(0),                       // 3: game.GameOutcome
+	(Termination)(0),                       // 4: game.Termination
+	(PieceType)(0),                         // 5: game.PieceType
+	(*Move)(nil),                           // 6: game.Move
+	(*CreateGameRequest)(nil),              // 7: game.CreateGameRequest
+	(*CreateGameResponse)(nil),             // 8: game.CreateGameResponse
+	(*CancelSearchRequest)(nil),            // 9: game.CancelSearchRequest
+	(*CancelSearchResponse)(nil),           // 10: game.CancelSearchResponse
+	(*CreateChallengeRequest)(nil),         // 11: game.CreateChallengeRequest
+	(*Challenge)(nil),                      // 12: game.Challenge
+	(*ChallengeActionRequest)(nil),         // 13: game.ChallengeActionRequest
+	(*AcceptChallengeResponse)(nil),        // 14: game.AcceptChallengeResponse
+	(*DeclineChallengeResponse)(nil),       // 15: game.DeclineChallengeResponse
+	(*ListIncomingChallengesRequest)(nil),  // 16: game.ListIncomingChallengesRequest
+	(*ListIncomingChallengesResponse)(nil), // 17: game.ListIncomingChallengesResponse
+	(*WatchChallengesRequest)(nil),         // 18: game.WatchChallengesRequest
+	(*ChallengeAnswer)(nil),                // 19: game.ChallengeAnswer
+	(*GetRatingsRequest)(nil),              // 20: game.GetRatingsRequest
+	(*GetRatingsResponse)(nil),             // 21: game.GetRatingsResponse
+	(*PlayerRating)(nil),                   // 22: game.PlayerRating
+	(*MatchEvent)(nil),                     // 23: game.MatchEvent
+	(*Queued)(nil),                         // 24: game.Queued
+	(*QueuePosition)(nil),                  // 25: game.QueuePosition
+	(*RatingWindow)(nil),                   // 26: game.RatingWindow
+	(*Matched)(nil),                        // 27: game.Matched
+	(*Timeout)(nil),                        // 28: game.Timeout
+	(*Cancelled)(nil),                      // 29: game.Cancelled
+	(*MakeMoveRequest)(nil),                // 30: game.MakeMoveRequest
+	(*MakeMoveResponse)(nil),               // 31: game.MakeMoveResponse
+	(*GetGameStatsRequest)(nil),            // 32: game.GetGameStatsRequest
+	(*GetGameStatsResponse)(nil),           // 33: game.GetGameStatsResponse
+	(*GetGameRequest)(nil),                 // 34: game.GetGameRequest
+	(*GetGameResponse)(nil),                // 35: game.GetGameResponse
+	(*GamePlayer)(nil),                     // 36: game.GamePlayer
+	(*GameTimeControl)(nil),                // 37: game.GameTimeControl
+	(*GameMove)(nil),                       // 38: game.GameMove
+	(*ListGamesRequest)(nil),               // 39: game.ListGamesRequest
+	(*ListGamesResponse)(nil),              // 40: game.ListGamesResponse
+	(*GameSummary)(nil),                    // 41: game.GameSummary
+	(*ExportPGNRequest)(nil),               // 42: game.ExportPGNRequest
+	(*ExportPGNResponse)(nil),              // 43: game.ExportPGNResponse
+	(*ImportPGNRequest)(nil),               // 44: game.ImportPGNRequest
+	(*ImportPGNResponse)(nil),              // 45: game.ImportPGNResponse
+	(*ExportPlayerGamesRequest)(nil),       // 46: game.ExportPlayerGamesRequest
+	(*ExportedGame)(nil),                   // 47: game.ExportedGame
+	(*PlayGameRequest)(nil),                // 48: game.PlayGameRequest
+	(*JoinGame)(nil),                       // 49: game.JoinGame
+	(*Resign)(nil),                         // 50: game.Resign
+	(*OfferDraw)(nil),                      // 51: game.OfferDraw
+	(*DrawResponse)(nil),                   // 52: game.DrawResponse
+	(*Abort)(nil),                          // 53: game.Abort
+	(*RequestTakeback)(nil),                // 54: game.RequestTakeback
+	(*TakebackResponse)(nil),               // 55: game.TakebackResponse
+	(*GameActionRequest)(nil),              // 56: game.GameActionRequest
+	(*RespondDrawRequest)(nil),             // 57: game.RespondDrawRequest
+	(*TakebackRequest)(nil),                // 58: game.TakebackRequest
+	(*RespondTakebackRequest)(nil),         // 59: game.RespondTakebackRequest
+	(*HeartbeatResponse)(nil),              // 60: game.HeartbeatResponse
+	(*ClaimAbandonmentRequest)(nil),        // 61: game.ClaimAbandonmentRequest
+	(*GameActionResponse)(nil),             // 62: game.GameActionResponse
+	(*GameEvent)(nil),                      // 63: game.GameEvent
+	(*WatchGameRequest)(nil),               // 64: game.WatchGameRequest
+	(*GameSnapshot)(nil),                   // 65: game.GameSnapshot
+	(*ClockTick)(nil),                      // 66: game.ClockTick
+	(*SpectatorCount)(nil),                 // 67: game.SpectatorCount
+	(*MoveMade)(nil),                       // 68: game.MoveMade
+	(*DrawOffered)(nil),                    // 69: game.DrawOffered
+	(*DrawDeclined)(nil),                   // 70: game.DrawDeclined
+	(*TakebackRequested)(nil),              // 71: game.TakebackRequested
+	(*TakebackDeclined)(nil),               // 72: game.TakebackDeclined
+	(*TakenBack)(nil),                      // 73: game.TakenBack
+	(*PlayerAway)(nil),                     // 74: game.PlayerAway
+	(*PlayerReturned)(nil),                 // 75: game.PlayerReturned
+	(*GameResult)(nil),                     // 76: game.GameResult
+	(*ActionRejected)(nil),                 // 77: game.ActionRejected
+	(*Piece)(nil),                          // 78: game.Piece
+	(*Game)(nil),                           // 79: game.Game
 }
 var file_game_protos_proto_depIdxs = []int32{
-	1, // 0: game.MakeMoveRequest.move:type_name -> game.Move
-	1, // 1: game.GetGameStatsResponse.moves:type_name -> game.Move
-	0, // 2: game.Piece.type:type_name -> game.PieceType
-	1, // 3: game.Game.moves:type_name -> game.Move
-	3, // 4: game.GameService.MakeMove:input_type -> game.MakeMoveRequest
-	2, // 5: game.GameService.CreateGame:input_type -> game.CreateGameRequest
-	5, // 6: game.GameService.GetGameStats:input_type -> game.GetGameStatsRequest
-	4, // 7: game.GameService.MakeMove:output_type -> game.MakeMoveResponse
-	9, // 8: game.GameService.CreateGame:output_type -> google.protobuf.Empty
-	6, // 9: game.GameService.GetGameStats:output_type -> game.GetGameStatsResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5,  // 0: game.Move.promotion:type_name -> game.PieceType
+	0,  // 1: game.CreateGameRequest.preferred_color:type_name -> game.Color
+	0,  // 2: game.CreateChallengeRequest.color:type_name -> game.Color
+	0,  // 3: game.Challenge.color:type_name -> game.Color
+	0,  // 4: game.AcceptChallengeResponse.color:type_name -> game.Color
+	12, // 5: game.ListIncomingChallengesResponse.challenges:type_name -> game.Challenge
+	0,  // 6: game.ChallengeAnswer.color:type_name -> game.Color
+	22, // 7: game.GetRatingsResponse.ratings:type_name -> game.PlayerRating
+	24, // 8: game.MatchEvent.queued:type_name -> game.Queued
+	25, // 9: game.MatchEvent.queue_position:type_name -> game.QueuePosition
+	26, // 10: game.MatchEvent.rating_window:type_name -> game.RatingWindow
+	27, // 11: game.MatchEvent.matched:type_name -> game.Matched
+	28, // 12: game.MatchEvent.timeout:type_name -> game.Timeout
+	29, // 13: game.MatchEvent.cancelled:type_name -> game.Cancelled
+	0,  // 14: game.Matched.color:type_name -> game.Color
+	6,  // 15: game.MakeMoveRequest.move:type_name -> game.Move
+	3,  // 16: game.MakeMoveResponse.outcome:type_name -> game.GameOutcome
+	4,  // 17: game.MakeMoveResponse.termination:type_name -> game.Termination
+	6,  // 18: game.GetGameStatsResponse.moves:type_name -> game.Move
+	36, // 19: game.GetGameResponse.white:type_name -> game.GamePlayer
+	36, // 20: game.GetGameResponse.black:type_name -> game.GamePlayer
+	37, // 21: game.GetGameResponse.time_control:type_name -> game.GameTimeControl
+	0,  // 22: game.GetGameResponse.turn:type_name -> game.Color
+	3,  // 23: game.GetGameResponse.outcome:type_name -> game.GameOutcome
+	4,  // 24: game.GetGameResponse.termination:type_name -> game.Termination
+	38, // 25: game.GetGameResponse.moves:type_name -> game.GameMove
+	0,  // 26: game.GamePlayer.color:type_name -> game.Color
+	0,  // 27: game.ListGamesRequest.color:type_name -> game.Color
+	1,  // 28: game.ListGamesRequest.result:type_name -> game.PlayerResult
+	41, // 29: game.ListGamesResponse.games:type_name -> game.GameSummary
+	3,  // 30: game.GameSummary.outcome:type_name -> game.GameOutcome
+	4,  // 31: game.GameSummary.termination:type_name -> game.Termination
+	2,  // 32: game.ExportPlayerGamesRequest.format:type_name -> game.ExportFormat
+	49, // 33: game.PlayGameRequest.join:type_name -> game.JoinGame
+	6,  // 34: game.PlayGameRequest.move:type_name -> game.Move
+	50, // 35: game.PlayGameRequest.resign:type_name -> game.Resign
+	51, // 36: game.PlayGameRequest.offer_draw:type_name -> game.OfferDraw
+	52, // 37: game.PlayGameRequest.draw_response:type_name -> game.DrawResponse
+	53, // 38: game.PlayGameRequest.abort:type_name -> game.Abort
+	54, // 39: game.PlayGameRequest.request_takeback:type_name -> game.RequestTakeback
+	55, // 40: game.PlayGameRequest.takeback_response:type_name -> game.TakebackResponse
+	3,  // 41: game.GameActionResponse.outcome:type_name -> game.GameOutcome
+	4,  // 42: game.GameActionResponse.termination:type_name -> game.Termination
+	68, // 43: game.GameEvent.move_made:type_name -> game.MoveMade
+	69, // 44: game.GameEvent.draw_offered:type_name -> game.DrawOffered
+	70, // 45: game.GameEvent.draw_declined:type_name -> game.DrawDeclined
+	76, // 46: game.GameEvent.result:type_name -> game.GameResult
+	77, // 47: game.GameEvent.rejected:type_name -> game.ActionRejected
+	71, // 48: game.GameEvent.takeback_requested:type_name -> game.TakebackRequested
+	72, // 49: game.GameEvent.takeback_declined:type_name -> game.TakebackDeclined
+	73, // 50: game.GameEvent.taken_back:type_name -> game.TakenBack
+	74, // 51: game.GameEvent.player_away:type_name -> game.PlayerAway
+	75, // 52: game.GameEvent.player_returned:type_name -> game.PlayerReturned
+	65, // 53: game.GameEvent.snapshot:type_name -> game.GameSnapshot
+	66, // 54: game.GameEvent.clock_tick:type_name -> game.ClockTick
+	67, // 55: game.GameEvent.spectators:type_name -> game.SpectatorCount
+	0,  // 56: game.GameSnapshot.turn:type_name -> game.Color
+	3,  // 57: game.GameSnapshot.outcome:type_name -> game.GameOutcome
+	4,  // 58: game.GameSnapshot.termination:type_name -> game.Termination
+	6,  // 59: game.MoveMade.move:type_name -> game.Move
+	3,  // 60: game.GameResult.outcome:type_name -> game.GameOutcome
+	4,  // 61: game.GameResult.termination:type_name -> game.Termination
+	5,  // 62: game.Piece.type:type_name -> game.PieceType
+	6,  // 63: game.Game.moves:type_name -> game.Move
+	30, // 64: game.GameService.MakeMove:input_type -> game.MakeMoveRequest
+	7,  // 65: game.GameService.CreateGame:input_type -> game.CreateGameRequest
+	32, // 66: game.GameService.GetGameStats:input_type -> game.GetGameStatsRequest
+	34, // 67: game.GameService.GetGame:input_type -> game.GetGameRequest
+	39, // 68: game.GameService.ListGames:input_type -> game.ListGamesRequest
+	42, // 69: game.GameService.ExportPGN:input_type -> game.ExportPGNRequest
+	44, // 70: game.GameService.ImportPGN:input_type -> game.ImportPGNRequest
+	46, // 71: game.GameService.ExportPlayerGames:input_type -> game.ExportPlayerGamesRequest
+	7,  // 72: game.GameService.FindMatch:input_type -> game.CreateGameRequest
+	9,  // 73: game.GameService.CancelSearch:input_type -> game.CancelSearchRequest
+	48, // 74: game.GameService.PlayGame:input_type -> game.PlayGameRequest
+	64, // 75: game.GameService.WatchGame:input_type -> game.WatchGameRequest
+	56, // 76: game.GameService.ResignGame:input_type -> game.GameActionRequest
+	56, // 77: game.GameService.OfferDraw:input_type -> game.GameActionRequest
+	57, // 78: game.GameService.RespondDraw:input_type -> game.RespondDrawRequest
+	56, // 79: game.GameService.AbortGame:input_type -> game.GameActionRequest
+	58, // 80: game.GameService.RequestTakeback:input_type -> game.TakebackRequest
+	59, // 81: game.GameService.RespondTakeback:input_type -> game.RespondTakebackRequest
+	56, // 82: game.GameService.Heartbeat:input_type -> game.GameActionRequest
+	61, // 83: game.GameService.ClaimAbandonment:input_type -> game.ClaimAbandonmentRequest
+	20, // 84: game.GameService.GetRatings:input_type -> game.GetRatingsRequest
+	11, // 85: game.GameService.CreateChallenge:input_type -> game.CreateChallengeRequest
+	13, // 86: game.GameService.AcceptChallenge:input_type -> game.ChallengeActionRequest
+	13, // 87: game.GameService.DeclineChallenge:input_type -> game.ChallengeActionRequest
+	16, // 88: game.GameService.ListIncomingChallenges:input_type -> game.ListIncomingChallengesRequest
+	18, // 89: game.GameService.WatchChallenges:input_type -> game.WatchChallengesRequest
+	31, // 90: game.GameService.MakeMove:output_type -> game.MakeMoveResponse
+	8,  // 91: game.GameService.CreateGame:output_type -> game.CreateGameResponse
+	33, // 92: game.GameService.GetGameStats:output_type -> game.GetGameStatsResponse
+	35, // 93: game.GameService.GetGame:output_type -> game.GetGameResponse
+	40, // 94: game.GameService.ListGames:output_type -> game.ListGamesResponse
+	43, // 95: game.GameService.ExportPGN:output_type -> game.ExportPGNResponse
+	45, // 96: game.GameService.ImportPGN:output_type -> game.ImportPGNResponse
+	47, // 97: game.GameService.ExportPlayerGames:output_type -> game.ExportedGame
+	23, // 98: game.GameService.FindMatch:output_type -> game.MatchEvent
+	10, // 99: game.GameService.CancelSearch:output_type -> game.CancelSearchResponse
+	63, // 100: game.GameService.PlayGame:output_type -> game.GameEvent
+	63, // 101: game.GameService.WatchGame:output_type -> game.GameEvent
+	62, // 102: game.GameService.ResignGame:output_type -> game.GameActionResponse
+	62, // 103: game.GameService.OfferDraw:output_type -> game.GameActionResponse
+	62, // 104: game.GameService.RespondDraw:output_type -> game.GameActionResponse
+	62, // 105: game.GameService.AbortGame:output_type -> game.GameActionResponse
+	62, // 106: game.GameService.RequestTakeback:output_type -> game.GameActionResponse
+	62, // 107: game.GameService.RespondTakeback:output_type -> game.GameActionResponse
+	60, // 108: game.GameService.Heartbeat:output_type -> game.HeartbeatResponse
+	62, // 109: game.GameService.ClaimAbandonment:output_type -> game.GameActionResponse
+	21, // 110: game.GameService.GetRatings:output_type -> game.GetRatingsResponse
+	12, // 111: game.GameService.CreateChallenge:output_type -> game.Challenge
+	14, // 112: game.GameService.AcceptChallenge:output_type -> game.AcceptChallengeResponse
+	15, // 113: game.GameService.DeclineChallenge:output_type -> game.DeclineChallengeResponse
+	17, // 114: game.GameService.ListIncomingChallenges:output_type -> game.ListIncomingChallengesResponse
+	19, // 115: game.GameService.WatchChallenges:output_type -> game.ChallengeAnswer
+	90, // [90:116] is the sub-list for method output_type
+	64, // [64:90] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_game_protos_proto_init() }
@@ -670,13 +6160,48 @@ func file_game_protos_proto_init() {
 	if File_game_protos_proto != nil {
 		return
 	}
+	file_game_protos_proto_msgTypes[0].OneofWrappers = []any{}
+	file_game_protos_proto_msgTypes[17].OneofWrappers = []any{
+		(*MatchEvent_Queued)(nil),
+		(*MatchEvent_QueuePosition)(nil),
+		(*MatchEvent_RatingWindow)(nil),
+		(*MatchEvent_Matched)(nil),
+		(*MatchEvent_Timeout)(nil),
+		(*MatchEvent_Cancelled)(nil),
+	}
+	file_game_protos_proto_msgTypes[33].OneofWrappers = []any{}
+	file_game_protos_proto_msgTypes[42].OneofWrappers = []any{
+		(*PlayGameRequest_Join)(nil),
+		(*PlayGameRequest_Move)(nil),
+		(*PlayGameRequest_Resign)(nil),
+		(*PlayGameRequest_OfferDraw)(nil),
+		(*PlayGameRequest_DrawResponse)(nil),
+		(*PlayGameRequest_Abort)(nil),
+		(*PlayGameRequest_RequestTakeback)(nil),
+		(*PlayGameRequest_TakebackResponse)(nil),
+	}
+	file_game_protos_proto_msgTypes[57].OneofWrappers = []any{
+		(*GameEvent_MoveMade)(nil),
+		(*GameEvent_DrawOffered)(nil),
+		(*GameEvent_DrawDeclined)(nil),
+		(*GameEvent_Result)(nil),
+		(*GameEvent_Rejected)(nil),
+		(*GameEvent_TakebackRequested)(nil),
+		(*GameEvent_TakebackDeclined)(nil),
+		(*GameEvent_TakenBack)(nil),
+		(*GameEvent_PlayerAway)(nil),
+		(*GameEvent_PlayerReturned)(nil),
+		(*GameEvent_Snapshot)(nil),
+		(*GameEvent_ClockTick)(nil),
+		(*GameEvent_Spectators)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_protos_proto_rawDesc), len(file_game_protos_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      6,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
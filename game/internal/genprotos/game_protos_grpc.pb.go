@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,9 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameService_MakeMove_FullMethodName     = "/game.GameService/MakeMove"
-	GameService_CreateGame_FullMethodName   = "/game.GameService/CreateGame"
-	GameService_GetGameStats_FullMethodName = "/game.GameService/GetGameStats"
+	GameService_MakeMove_FullMethodName               = "/game.GameService/MakeMove"
+	GameService_CreateGame_FullMethodName             = "/game.GameService/CreateGame"
+	GameService_GetGameStats_FullMethodName           = "/game.GameService/GetGameStats"
+	GameService_GetGame_FullMethodName                = "/game.GameService/GetGame"
+	GameService_ListGames_FullMethodName              = "/game.GameService/ListGames"
+	GameService_ExportPGN_FullMethodName              = "/game.GameService/ExportPGN"
+	GameService_ImportPGN_FullMethodName              = "/game.GameService/ImportPGN"
+	GameService_ExportPlayerGames_FullMethodName      = "/game.GameService/ExportPlayerGames"
+	GameService_FindMatch_FullMethodName              = "/game.GameService/FindMatch"
+	GameService_CancelSearch_FullMethodName           = "/game.GameService/CancelSearch"
+	GameService_PlayGame_FullMethodName               = "/game.GameService/PlayGame"
+	GameService_WatchGame_FullMethodName              = "/game.GameService/WatchGame"
+	GameService_ResignGame_FullMethodName             = "/game.GameService/ResignGame"
+	GameService_OfferDraw_FullMethodName              = "/game.GameService/OfferDraw"
+	GameService_RespondDraw_FullMethodName            = "/game.GameService/RespondDraw"
+	GameService_AbortGame_FullMethodName              = "/game.GameService/AbortGame"
+	GameService_RequestTakeback_FullMethodName        = "/game.GameService/RequestTakeback"
+	GameService_RespondTakeback_FullMethodName        = "/game.GameService/RespondTakeback"
+	GameService_Heartbeat_FullMethodName              = "/game.GameService/Heartbeat"
+	GameService_ClaimAbandonment_FullMethodName       = "/game.GameService/ClaimAbandonment"
+	GameService_GetRatings_FullMethodName             = "/game.GameService/GetRatings"
+	GameService_CreateChallenge_FullMethodName        = "/game.GameService/CreateChallenge"
+	GameService_AcceptChallenge_FullMethodName        = "/game.GameService/AcceptChallenge"
+	GameService_DeclineChallenge_FullMethodName       = "/game.GameService/DeclineChallenge"
+	GameService_ListIncomingChallenges_FullMethodName = "/game.GameService/ListIncomingChallenges"
+	GameService_WatchChallenges_FullMethodName        = "/game.GameService/WatchChallenges"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -30,8 +52,31 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GameServiceClient interface {
 	MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error)
-	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
 	GetGameStats(ctx context.Context, in *GetGameStatsRequest, opts ...grpc.CallOption) (*GetGameStatsResponse, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error)
+	ExportPGN(ctx context.Context, in *ExportPGNRequest, opts ...grpc.CallOption) (*ExportPGNResponse, error)
+	ImportPGN(ctx context.Context, in *ImportPGNRequest, opts ...grpc.CallOption) (*ImportPGNResponse, error)
+	ExportPlayerGames(ctx context.Context, in *ExportPlayerGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedGame], error)
+	FindMatch(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchEvent], error)
+	CancelSearch(ctx context.Context, in *CancelSearchRequest, opts ...grpc.CallOption) (*CancelSearchResponse, error)
+	PlayGame(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlayGameRequest, GameEvent], error)
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error)
+	ResignGame(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	OfferDraw(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	RespondDraw(ctx context.Context, in *RespondDrawRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	AbortGame(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	RequestTakeback(ctx context.Context, in *TakebackRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	RespondTakeback(ctx context.Context, in *RespondTakebackRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	Heartbeat(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	ClaimAbandonment(ctx context.Context, in *ClaimAbandonmentRequest, opts ...grpc.CallOption) (*GameActionResponse, error)
+	GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error)
+	CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	AcceptChallenge(ctx context.Context, in *ChallengeActionRequest, opts ...grpc.CallOption) (*AcceptChallengeResponse, error)
+	DeclineChallenge(ctx context.Context, in *ChallengeActionRequest, opts ...grpc.CallOption) (*DeclineChallengeResponse, error)
+	ListIncomingChallenges(ctx context.Context, in *ListIncomingChallengesRequest, opts ...grpc.CallOption) (*ListIncomingChallengesResponse, error)
+	WatchChallenges(ctx context.Context, in *WatchChallengesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChallengeAnswer], error)
 }
 
 type gameServiceClient struct {
@@ -52,9 +97,9 @@ func (c *gameServiceClient) MakeMove(ctx context.Context, in *MakeMoveRequest, o
 	return out, nil
 }
 
-func (c *gameServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *gameServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(CreateGameResponse)
 	err := c.cc.Invoke(ctx, GameService_CreateGame_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -72,13 +117,305 @@ func (c *gameServiceClient) GetGameStats(ctx context.Context, in *GetGameStatsRe
 	return out, nil
 }
 
+func (c *gameServiceClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameResponse)
+	err := c.cc.Invoke(ctx, GameService_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*ListGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGamesResponse)
+	err := c.cc.Invoke(ctx, GameService_ListGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ExportPGN(ctx context.Context, in *ExportPGNRequest, opts ...grpc.CallOption) (*ExportPGNResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPGNResponse)
+	err := c.cc.Invoke(ctx, GameService_ExportPGN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ImportPGN(ctx context.Context, in *ImportPGNRequest, opts ...grpc.CallOption) (*ImportPGNResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportPGNResponse)
+	err := c.cc.Invoke(ctx, GameService_ImportPGN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ExportPlayerGames(ctx context.Context, in *ExportPlayerGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportedGame], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[0], GameService_ExportPlayerGames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPlayerGamesRequest, ExportedGame]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_ExportPlayerGamesClient = grpc.ServerStreamingClient[ExportedGame]
+
+func (c *gameServiceClient) FindMatch(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[1], GameService_FindMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateGameRequest, MatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_FindMatchClient = grpc.ServerStreamingClient[MatchEvent]
+
+func (c *gameServiceClient) CancelSearch(ctx context.Context, in *CancelSearchRequest, opts ...grpc.CallOption) (*CancelSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSearchResponse)
+	err := c.cc.Invoke(ctx, GameService_CancelSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) PlayGame(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PlayGameRequest, GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[2], GameService_PlayGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PlayGameRequest, GameEvent]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_PlayGameClient = grpc.BidiStreamingClient[PlayGameRequest, GameEvent]
+
+func (c *gameServiceClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[3], GameService_WatchGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRequest, GameEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGameClient = grpc.ServerStreamingClient[GameEvent]
+
+func (c *gameServiceClient) ResignGame(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_ResignGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) OfferDraw(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_OfferDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RespondDraw(ctx context.Context, in *RespondDrawRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_RespondDraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AbortGame(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_AbortGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RequestTakeback(ctx context.Context, in *TakebackRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_RequestTakeback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RespondTakeback(ctx context.Context, in *RespondTakebackRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_RespondTakeback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) Heartbeat(ctx context.Context, in *GameActionRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, GameService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ClaimAbandonment(ctx context.Context, in *ClaimAbandonmentRequest, opts ...grpc.CallOption) (*GameActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameActionResponse)
+	err := c.cc.Invoke(ctx, GameService_ClaimAbandonment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetRatings(ctx context.Context, in *GetRatingsRequest, opts ...grpc.CallOption) (*GetRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingsResponse)
+	err := c.cc.Invoke(ctx, GameService_GetRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, GameService_CreateChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AcceptChallenge(ctx context.Context, in *ChallengeActionRequest, opts ...grpc.CallOption) (*AcceptChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptChallengeResponse)
+	err := c.cc.Invoke(ctx, GameService_AcceptChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DeclineChallenge(ctx context.Context, in *ChallengeActionRequest, opts ...grpc.CallOption) (*DeclineChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineChallengeResponse)
+	err := c.cc.Invoke(ctx, GameService_DeclineChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ListIncomingChallenges(ctx context.Context, in *ListIncomingChallengesRequest, opts ...grpc.CallOption) (*ListIncomingChallengesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncomingChallengesResponse)
+	err := c.cc.Invoke(ctx, GameService_ListIncomingChallenges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) WatchChallenges(ctx context.Context, in *WatchChallengesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChallengeAnswer], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[4], GameService_WatchChallenges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChallengesRequest, ChallengeAnswer]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchChallengesClient = grpc.ServerStreamingClient[ChallengeAnswer]
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
 type GameServiceServer interface {
 	MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error)
-	CreateGame(context.Context, *CreateGameRequest) (*emptypb.Empty, error)
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
 	GetGameStats(context.Context, *GetGameStatsRequest) (*GetGameStatsResponse, error)
+	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
+	ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error)
+	ExportPGN(context.Context, *ExportPGNRequest) (*ExportPGNResponse, error)
+	ImportPGN(context.Context, *ImportPGNRequest) (*ImportPGNResponse, error)
+	ExportPlayerGames(*ExportPlayerGamesRequest, grpc.ServerStreamingServer[ExportedGame]) error
+	FindMatch(*CreateGameRequest, grpc.ServerStreamingServer[MatchEvent]) error
+	CancelSearch(context.Context, *CancelSearchRequest) (*CancelSearchResponse, error)
+	PlayGame(grpc.BidiStreamingServer[PlayGameRequest, GameEvent]) error
+	WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error
+	ResignGame(context.Context, *GameActionRequest) (*GameActionResponse, error)
+	OfferDraw(context.Context, *GameActionRequest) (*GameActionResponse, error)
+	RespondDraw(context.Context, *RespondDrawRequest) (*GameActionResponse, error)
+	AbortGame(context.Context, *GameActionRequest) (*GameActionResponse, error)
+	RequestTakeback(context.Context, *TakebackRequest) (*GameActionResponse, error)
+	RespondTakeback(context.Context, *RespondTakebackRequest) (*GameActionResponse, error)
+	Heartbeat(context.Context, *GameActionRequest) (*HeartbeatResponse, error)
+	ClaimAbandonment(context.Context, *ClaimAbandonmentRequest) (*GameActionResponse, error)
+	GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error)
+	CreateChallenge(context.Context, *CreateChallengeRequest) (*Challenge, error)
+	AcceptChallenge(context.Context, *ChallengeActionRequest) (*AcceptChallengeResponse, error)
+	DeclineChallenge(context.Context, *ChallengeActionRequest) (*DeclineChallengeResponse, error)
+	ListIncomingChallenges(context.Context, *ListIncomingChallengesRequest) (*ListIncomingChallengesResponse, error)
+	WatchChallenges(*WatchChallengesRequest, grpc.ServerStreamingServer[ChallengeAnswer]) error
 	mustEmbedUnimplementedGameServiceServer()
 }
 
@@ -92,12 +429,81 @@ type UnimplementedGameServiceServer struct{}
 func (UnimplementedGameServiceServer) MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakeMove not implemented")
 }
-func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameRequest) (*emptypb.Empty, error) {
+func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
 }
 func (UnimplementedGameServiceServer) GetGameStats(context.Context, *GetGameStatsRequest) (*GetGameStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGameStats not implemented")
 }
+func (UnimplementedGameServiceServer) GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedGameServiceServer) ListGames(context.Context, *ListGamesRequest) (*ListGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (UnimplementedGameServiceServer) ExportPGN(context.Context, *ExportPGNRequest) (*ExportPGNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPGN not implemented")
+}
+func (UnimplementedGameServiceServer) ImportPGN(context.Context, *ImportPGNRequest) (*ImportPGNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPGN not implemented")
+}
+func (UnimplementedGameServiceServer) ExportPlayerGames(*ExportPlayerGamesRequest, grpc.ServerStreamingServer[ExportedGame]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPlayerGames not implemented")
+}
+func (UnimplementedGameServiceServer) FindMatch(*CreateGameRequest, grpc.ServerStreamingServer[MatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method FindMatch not implemented")
+}
+func (UnimplementedGameServiceServer) CancelSearch(context.Context, *CancelSearchRequest) (*CancelSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSearch not implemented")
+}
+func (UnimplementedGameServiceServer) PlayGame(grpc.BidiStreamingServer[PlayGameRequest, GameEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method PlayGame not implemented")
+}
+func (UnimplementedGameServiceServer) WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedGameServiceServer) ResignGame(context.Context, *GameActionRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResignGame not implemented")
+}
+func (UnimplementedGameServiceServer) OfferDraw(context.Context, *GameActionRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferDraw not implemented")
+}
+func (UnimplementedGameServiceServer) RespondDraw(context.Context, *RespondDrawRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondDraw not implemented")
+}
+func (UnimplementedGameServiceServer) AbortGame(context.Context, *GameActionRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortGame not implemented")
+}
+func (UnimplementedGameServiceServer) RequestTakeback(context.Context, *TakebackRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestTakeback not implemented")
+}
+func (UnimplementedGameServiceServer) RespondTakeback(context.Context, *RespondTakebackRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondTakeback not implemented")
+}
+func (UnimplementedGameServiceServer) Heartbeat(context.Context, *GameActionRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedGameServiceServer) ClaimAbandonment(context.Context, *ClaimAbandonmentRequest) (*GameActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAbandonment not implemented")
+}
+func (UnimplementedGameServiceServer) GetRatings(context.Context, *GetRatingsRequest) (*GetRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatings not implemented")
+}
+func (UnimplementedGameServiceServer) CreateChallenge(context.Context, *CreateChallengeRequest) (*Challenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) AcceptChallenge(context.Context, *ChallengeActionRequest) (*AcceptChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) DeclineChallenge(context.Context, *ChallengeActionRequest) (*DeclineChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) ListIncomingChallenges(context.Context, *ListIncomingChallengesRequest) (*ListIncomingChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncomingChallenges not implemented")
+}
+func (UnimplementedGameServiceServer) WatchChallenges(*WatchChallengesRequest, grpc.ServerStreamingServer[ChallengeAnswer]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChallenges not implemented")
+}
 func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
 func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
@@ -173,6 +579,381 @@ func _GameService_GetGameStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ExportPGN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPGNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ExportPGN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ExportPGN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ExportPGN(ctx, req.(*ExportPGNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ImportPGN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPGNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ImportPGN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ImportPGN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ImportPGN(ctx, req.(*ImportPGNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ExportPlayerGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPlayerGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).ExportPlayerGames(m, &grpc.GenericServerStream[ExportPlayerGamesRequest, ExportedGame]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_ExportPlayerGamesServer = grpc.ServerStreamingServer[ExportedGame]
+
+func _GameService_FindMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).FindMatch(m, &grpc.GenericServerStream[CreateGameRequest, MatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_FindMatchServer = grpc.ServerStreamingServer[MatchEvent]
+
+func _GameService_CancelSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CancelSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CancelSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CancelSearch(ctx, req.(*CancelSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_PlayGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GameServiceServer).PlayGame(&grpc.GenericServerStream[PlayGameRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_PlayGameServer = grpc.BidiStreamingServer[PlayGameRequest, GameEvent]
+
+func _GameService_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchGame(m, &grpc.GenericServerStream[WatchGameRequest, GameEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGameServer = grpc.ServerStreamingServer[GameEvent]
+
+func _GameService_ResignGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ResignGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ResignGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ResignGame(ctx, req.(*GameActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_OfferDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).OfferDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_OfferDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).OfferDraw(ctx, req.(*GameActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RespondDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RespondDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RespondDraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RespondDraw(ctx, req.(*RespondDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AbortGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AbortGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AbortGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AbortGame(ctx, req.(*GameActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RequestTakeback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakebackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RequestTakeback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RequestTakeback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RequestTakeback(ctx, req.(*TakebackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RespondTakeback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondTakebackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RespondTakeback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RespondTakeback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RespondTakeback(ctx, req.(*RespondTakebackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GameActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).Heartbeat(ctx, req.(*GameActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ClaimAbandonment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimAbandonmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ClaimAbandonment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ClaimAbandonment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ClaimAbandonment(ctx, req.(*ClaimAbandonmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetRatings(ctx, req.(*GetRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_CreateChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateChallenge(ctx, req.(*CreateChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AcceptChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AcceptChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AcceptChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AcceptChallenge(ctx, req.(*ChallengeActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DeclineChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DeclineChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DeclineChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DeclineChallenge(ctx, req.(*ChallengeActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ListIncomingChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomingChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListIncomingChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListIncomingChallenges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListIncomingChallenges(ctx, req.(*ListIncomingChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_WatchChallenges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChallengesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchChallenges(m, &grpc.GenericServerStream[WatchChallengesRequest, ChallengeAnswer]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchChallengesServer = grpc.ServerStreamingServer[ChallengeAnswer]
+
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,7 +973,106 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGameStats",
 			Handler:    _GameService_GetGameStats_Handler,
 		},
+		{
+			MethodName: "GetGame",
+			Handler:    _GameService_GetGame_Handler,
+		},
+		{
+			MethodName: "ListGames",
+			Handler:    _GameService_ListGames_Handler,
+		},
+		{
+			MethodName: "ExportPGN",
+			Handler:    _GameService_ExportPGN_Handler,
+		},
+		{
+			MethodName: "ImportPGN",
+			Handler:    _GameService_ImportPGN_Handler,
+		},
+		{
+			MethodName: "CancelSearch",
+			Handler:    _GameService_CancelSearch_Handler,
+		},
+		{
+			MethodName: "ResignGame",
+			Handler:    _GameService_ResignGame_Handler,
+		},
+		{
+			MethodName: "OfferDraw",
+			Handler:    _GameService_OfferDraw_Handler,
+		},
+		{
+			MethodName: "RespondDraw",
+			Handler:    _GameService_RespondDraw_Handler,
+		},
+		{
+			MethodName: "AbortGame",
+			Handler:    _GameService_AbortGame_Handler,
+		},
+		{
+			MethodName: "RequestTakeback",
+			Handler:    _GameService_RequestTakeback_Handler,
+		},
+		{
+			MethodName: "RespondTakeback",
+			Handler:    _GameService_RespondTakeback_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _GameService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ClaimAbandonment",
+			Handler:    _GameService_ClaimAbandonment_Handler,
+		},
+		{
+			MethodName: "GetRatings",
+			Handler:    _GameService_GetRatings_Handler,
+		},
+		{
+			MethodName: "CreateChallenge",
+			Handler:    _GameService_CreateChallenge_Handler,
+		},
+		{
+			MethodName: "AcceptChallenge",
+			Handler:    _GameService_AcceptChallenge_Handler,
+		},
+		{
+			MethodName: "DeclineChallenge",
+			Handler:    _GameService_DeclineChallenge_Handler,
+		},
+		{
+			MethodName: "ListIncomingChallenges",
+			Handler:    _GameService_ListIncomingChallenges_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPlayerGames",
+			Handler:       _GameService_ExportPlayerGames_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindMatch",
+			Handler:       _GameService_FindMatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlayGame",
+			Handler:       _GameService_PlayGame_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchGame",
+			Handler:       _GameService_WatchGame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchChallenges",
+			Handler:       _GameService_WatchChallenges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "game_protos.proto",
 }
@@ -1,15 +1,115 @@
 package models
 
 import (
+	"time"
+
+	"github.com/notnil/chess"
 	"github.com/ruziba3vich/chess_app/internal/genprotos"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// statuses of a game
+const (
+	StatusOngoing  = "ongoing"
+	StatusFinished = "finished"
+	StatusAborted  = "aborted" // ended before both players moved, it has no result
+)
+
 type (
 	GameModel struct {
-		ID       primitive.ObjectID `bson:"_id,omitempty"`
-		Players  []string           `bson:"players"`
-		Duration int8               `bson:"duration"`
-		Moves    []genprotos.Move   `bson:"moves"`
+		ID          primitive.ObjectID `bson:"_id,omitempty"`
+		Players     []string           `bson:"players"` // the one at index 0 is white
+		White       string             `bson:"white"`
+		Black       string             `bson:"black"`
+		Duration    int8               `bson:"duration"`
+		TimeControl string             `bson:"time_control,omitempty"` // base+increment in seconds, e.g. "180+2"
+		BaseMs      int64              `bson:"base_ms,omitempty"`      // the full time control, TimeControl is its tag in PGN
+		IncrementMs int64              `bson:"increment_ms,omitempty"`
+		DelayMs     int64              `bson:"delay_ms,omitempty"`
+		Rated       bool               `bson:"rated"`
+		Moves       []MoveRecord       `bson:"moves"`
+		Status      string             `bson:"status"`
+		Result      string             `bson:"result,omitempty"` // "1-0", "0-1", "1/2-1/2" or "*"
+		Termination string             `bson:"termination,omitempty"`
+		PGN         string             `bson:"pgn,omitempty"`
+		StartFEN    string             `bson:"start_fen,omitempty"`
+		FinalFEN    string             `bson:"final_fen,omitempty"`
+		StartedAt   time.Time          `bson:"started_at"`
+		EndedAt     time.Time          `bson:"ended_at,omitempty"`
+		WhiteClock  int64              `bson:"white_clock_ms,omitempty"` // time white had left when the game ended
+		BlackClock  int64              `bson:"black_clock_ms,omitempty"`
+		// ratings of the players before a rated game and how they changed, set once the ratings are updated
+		WhiteRating     int  `bson:"white_rating,omitempty"`
+		BlackRating     int  `bson:"black_rating,omitempty"`
+		WhiteRatingDiff int  `bson:"white_rating_diff,omitempty"`
+		BlackRatingDiff int  `bson:"black_rating_diff,omitempty"`
+		RatingsApplied  bool `bson:"ratings_applied,omitempty"`
+		// set on games imported from PGN, which were not played here. They are always finished, with the result "*"
+		// if the PGN had none, and their players are the names of the PGN rather than player ids
+		Imported   bool              `bson:"imported,omitempty"`
+		ImportedBy string            `bson:"imported_by,omitempty"`
+		ImportedAt time.Time         `bson:"imported_at,omitempty"`
+		Tags       map[string]string `bson:"tags,omitempty"` // tag pairs of the imported PGN that have no field of their own
+	}
+
+	// RatingModel is a player's Glicko-2 rating for the games of one duration
+	RatingModel struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty"`
+		PlayerID   string             `bson:"player_id"`
+		Duration   int8               `bson:"duration"`
+		Rating     float64            `bson:"rating"`
+		Deviation  float64            `bson:"deviation"`
+		Volatility float64            `bson:"volatility"`
+		Games      int                `bson:"games"`
+		UpdatedAt  time.Time          `bson:"updated_at"`
+	}
+
+	// MoveRecord is a single move of a game as it is archived
+	MoveRecord struct {
+		UCI      string    `bson:"uci" json:"uci"`
+		SAN      string    `bson:"san" json:"san"`
+		PlayedAt time.Time `bson:"played_at" json:"played_at"`
+		ClockMs  int64     `bson:"clock_ms" json:"clock_ms"` // time the mover had left after the move
+		// TakenBack marks a move that was undone by agreement, it stays in the list but is not part of the game
+		TakenBack bool `bson:"taken_back,omitempty" json:"taken_back,omitempty"`
+	}
+
+	// Challenge is an invitation of one player to a game against another, it is kept in Redis until it expires
+	Challenge struct {
+		ID         string
+		Challenger string // id of the player who sent the challenge
+		Challenged string
+		Duration   int8
+		Rated      bool
+		Color      genprotos.Color // colour the challenger asked for, NO_COLOR for a random one
+		CreatedAt  time.Time
+		ExpiresAt  time.Time
+	}
+
+	// LiveGame is the state of a game in progress, it is kept in Redis until the game is archived
+	LiveGame struct {
+		Game        *chess.Game // rebuilt from StartFEN and the moves played since
+		StartFEN    string
+		White       string // id of the player playing white
+		Black       string
+		Base        time.Duration // time each player started with
+		WhiteClock  time.Duration // remaining time as of TurnStarted, the clock of the side to move runs since then
+		BlackClock  time.Duration
+		Increment   time.Duration // added to the mover's clock after every move
+		Delay       time.Duration // spent from every move before the clock starts running
+		TurnStarted time.Time     // when the side to move got the turn
+		Records     []MoveRecord  // one record for every move played
+		DrawOffer   chess.Color   // colour of the player whose draw offer is open, NoColor if there is none
+		WhiteOffer  int           // number of moves played when white last offered a draw, -1 if never
+		BlackOffer  int
+		Rated       bool
+		Takebacks   bool        // whether the players may take moves back
+		Takeback    chess.Color // colour of the player whose takeback request is open, NoColor if there is none
+		TakebackPly int         // number of moves the open takeback request undoes
+		Status      string
+		Outcome     genprotos.GameOutcome
+		Termination genprotos.Termination
+		StartedAt   time.Time
+		Version     int64 // incremented on every save
 	}
 )
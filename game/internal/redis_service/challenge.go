@@ -0,0 +1,164 @@
+package redisservice
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// fields of the redis hash a challenge is stored in
+const (
+	fieldChallenger = "challenger"
+	fieldChallenged = "challenged"
+	fieldDuration   = "duration"
+	fieldColor      = "color"
+	fieldCreatedAt  = "created_at" // unix milliseconds
+	fieldExpiresAt  = "expires_at" // unix milliseconds
+)
+
+func challengeKey(challengeID string) string {
+	return "challenge:" + challengeID
+}
+
+// incomingChallengesKey is a sorted set of the challenges a player received, scored by the unix
+// milliseconds they expire at
+func incomingChallengesKey(playerID string) string {
+	return "player:" + playerID + ":incoming_challenges"
+}
+
+// SaveChallenge stores the challenge until it expires and lists it among the challenged player's challenges
+func (r *RedisStorage) SaveChallenge(challenge *models.Challenge) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	key := challengeKey(challenge.ID)
+	incoming := incomingChallengesKey(challenge.Challenged)
+	expiresAt := challenge.ExpiresAt.UnixMilli()
+
+	conn.Send("MULTI")
+	conn.Send("HSET", redis.Args{key}.AddFlat(EncodeChallenge(challenge))...)
+	conn.Send("PEXPIREAT", key, expiresAt)
+	conn.Send("ZADD", incoming, expiresAt, challenge.ID)
+	// the list lives as long as its last challenge, a challenge put back after a failed accept expires
+	// before the newer ones and must not cut their time short
+	conn.Send("PEXPIREAT", incoming, expiresAt, "NX")
+	conn.Send("PEXPIREAT", incoming, expiresAt, "GT")
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// GetChallenge returns the challenge, or nil if it does not exist or expired
+func (r *RedisStorage) GetChallenge(challengeID string) (*models.Challenge, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	fields, err := redis.StringMap(conn.Do("HGETALL", challengeKey(challengeID)))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return DecodeChallenge(challengeID, fields)
+}
+
+// TakeChallenge removes the challenge and reports whether it still existed,
+// of concurrent calls for the same challenge only one gets true
+func (r *RedisStorage) TakeChallenge(challenge *models.Challenge) (bool, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("DEL", challengeKey(challenge.ID))
+	conn.Send("ZREM", incomingChallengesKey(challenge.Challenged), challenge.ID)
+	replies, err := redis.Int64s(conn.Do("EXEC"))
+	if err != nil {
+		return false, err
+	}
+	return replies[0] == 1, nil
+}
+
+// IncomingChallenges returns the challenges the player received that did not expire yet, the oldest first
+func (r *RedisStorage) IncomingChallenges(playerID string, now time.Time) ([]*models.Challenge, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	incoming := incomingChallengesKey(playerID)
+	conn.Send("MULTI")
+	conn.Send("ZREMRANGEBYSCORE", incoming, "-inf", now.UnixMilli())
+	conn.Send("ZRANGE", incoming, 0, -1)
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return nil, err
+	}
+	ids, err := redis.Strings(replies[1], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		conn.Send("HGETALL", challengeKey(id))
+	}
+	conn.Flush()
+	challenges := make([]*models.Challenge, 0, len(ids))
+	for _, id := range ids {
+		fields, err := redis.StringMap(conn.Receive())
+		if err != nil {
+			return nil, err
+		}
+		// taken between the two round trips
+		if len(fields) == 0 {
+			continue
+		}
+		challenge, err := DecodeChallenge(id, fields)
+		if err != nil {
+			return nil, err
+		}
+		challenges = append(challenges, challenge)
+	}
+	return challenges, nil
+}
+
+// EncodeChallenge flattens the challenge into the fields of its redis hash
+func EncodeChallenge(challenge *models.Challenge) map[string]string {
+	return map[string]string{
+		fieldChallenger: challenge.Challenger,
+		fieldChallenged: challenge.Challenged,
+		fieldDuration:   strconv.Itoa(int(challenge.Duration)),
+		fieldRated:      strconv.FormatBool(challenge.Rated),
+		fieldColor:      challenge.Color.String(),
+		fieldCreatedAt:  strconv.FormatInt(challenge.CreatedAt.UnixMilli(), 10),
+		fieldExpiresAt:  strconv.FormatInt(challenge.ExpiresAt.UnixMilli(), 10),
+	}
+}
+
+// DecodeChallenge rebuilds the challenge with the given id from the fields of its redis hash
+func DecodeChallenge(challengeID string, fields map[string]string) (*models.Challenge, error) {
+	duration, err := strconv.ParseInt(fields[fieldDuration], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenge duration: %s", err.Error())
+	}
+	createdAt, err := strconv.ParseInt(fields[fieldCreatedAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenge creation time: %s", err.Error())
+	}
+	expiresAt, err := strconv.ParseInt(fields[fieldExpiresAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenge expiry: %s", err.Error())
+	}
+
+	return &models.Challenge{
+		ID:         challengeID,
+		Challenger: fields[fieldChallenger],
+		Challenged: fields[fieldChallenged],
+		Duration:   int8(duration),
+		Rated:      fields[fieldRated] == "true",
+		Color:      genprotos.Color(genprotos.Color_value[fields[fieldColor]]),
+		CreatedAt:  time.UnixMilli(createdAt),
+		ExpiresAt:  time.UnixMilli(expiresAt),
+	}, nil
+}
@@ -0,0 +1,247 @@
+package redisservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"github.com/ruziba3vich/chess_app/pkg/config"
+)
+
+// fields of the redis hash a live game is stored in
+const (
+	fieldStartFEN    = "start_fen"
+	fieldWhite       = "white"
+	fieldBlack       = "black"
+	fieldMoves       = "moves" // space separated moves in UCI notation
+	fieldTurn        = "turn"
+	fieldWhiteClock  = "white_clock" // remaining time in milliseconds
+	fieldBlackClock  = "black_clock"
+	fieldRecords     = "records"    // json array of the move records
+	fieldDrawOffer   = "draw_offer" // colour with an open draw offer in FEN notation, "-" if there is none
+	fieldWhiteOffer  = "white_offer_ply"
+	fieldBlackOffer  = "black_offer_ply"
+	fieldRated       = "rated"
+	fieldTakebacks   = "takebacks"      // whether takebacks are allowed
+	fieldTakeback    = "takeback"       // colour with an open takeback request, "-" if there is none
+	fieldTakebackPly = "takeback_plies" // number of moves the open takeback request undoes
+	fieldStatus      = "status"
+	fieldOutcome     = "outcome"
+	fieldTermination = "termination"
+	fieldBase        = "base"            // milliseconds
+	fieldIncrement   = "increment"       // milliseconds
+	fieldDelay       = "delay"           // milliseconds
+	fieldTurnStarted = "turn_started_at" // unix milliseconds
+	fieldStartedAt   = "started_at"      // unix milliseconds
+	fieldVersion     = "version"
+)
+
+// NewLiveGame creates the live state of a game that starts from the standard position
+func NewLiveGame(white, black string, timeControl config.TimeControl) *models.LiveGame {
+	game := chess.NewGame(chess.UseNotation(chess.UCINotation{}))
+	now := time.Now()
+	return &models.LiveGame{
+		Game:        game,
+		StartFEN:    game.FEN(),
+		White:       white,
+		Black:       black,
+		Base:        timeControl.Base,
+		WhiteClock:  timeControl.Base,
+		BlackClock:  timeControl.Base,
+		Increment:   timeControl.Increment,
+		Delay:       timeControl.Delay,
+		TurnStarted: now,
+		DrawOffer:   chess.NoColor,
+		WhiteOffer:  -1,
+		BlackOffer:  -1,
+		Takeback:    chess.NoColor,
+		Status:      models.StatusOngoing,
+		StartedAt:   now,
+	}
+}
+
+// EncodeLiveGame flattens the live game into the fields of its redis hash
+func EncodeLiveGame(game *models.LiveGame) (map[string]string, error) {
+	moves := game.Game.Moves()
+	positions := game.Game.Positions()
+	uciMoves := make([]string, len(moves))
+	for i, move := range moves {
+		uciMoves[i] = chess.UCINotation{}.Encode(positions[i], move)
+	}
+
+	records := game.Records
+	if records == nil {
+		records = []models.MoveRecord{}
+	}
+	recordsJSON, err := json.Marshal(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		fieldStartFEN:    game.StartFEN,
+		fieldWhite:       game.White,
+		fieldBlack:       game.Black,
+		fieldMoves:       strings.Join(uciMoves, " "),
+		fieldTurn:        game.Game.Position().Turn().String(),
+		fieldWhiteClock:  strconv.FormatInt(game.WhiteClock.Milliseconds(), 10),
+		fieldBlackClock:  strconv.FormatInt(game.BlackClock.Milliseconds(), 10),
+		fieldBase:        strconv.FormatInt(game.Base.Milliseconds(), 10),
+		fieldIncrement:   strconv.FormatInt(game.Increment.Milliseconds(), 10),
+		fieldDelay:       strconv.FormatInt(game.Delay.Milliseconds(), 10),
+		fieldTurnStarted: strconv.FormatInt(game.TurnStarted.UnixMilli(), 10),
+		fieldRecords:     string(recordsJSON),
+		fieldDrawOffer:   game.DrawOffer.String(),
+		fieldWhiteOffer:  strconv.Itoa(game.WhiteOffer),
+		fieldBlackOffer:  strconv.Itoa(game.BlackOffer),
+		fieldRated:       strconv.FormatBool(game.Rated),
+		fieldTakebacks:   strconv.FormatBool(game.Takebacks),
+		fieldTakeback:    game.Takeback.String(),
+		fieldTakebackPly: strconv.Itoa(game.TakebackPly),
+		fieldStatus:      game.Status,
+		fieldOutcome:     game.Outcome.String(),
+		fieldTermination: game.Termination.String(),
+		fieldStartedAt:   strconv.FormatInt(game.StartedAt.UnixMilli(), 10),
+		fieldVersion:     strconv.FormatInt(game.Version, 10),
+	}, nil
+}
+
+// DecodeLiveGame rebuilds the live game from the fields of its redis hash by replaying its moves
+func DecodeLiveGame(fields map[string]string) (*models.LiveGame, error) {
+	fen, err := chess.FEN(fields[fieldStartFEN])
+	if err != nil {
+		return nil, fmt.Errorf("invalid starting position: %s", err.Error())
+	}
+	game := chess.NewGame(fen, chess.UseNotation(chess.UCINotation{}))
+	for _, move := range strings.Fields(fields[fieldMoves]) {
+		if err := game.MoveStr(move); err != nil {
+			return nil, fmt.Errorf("invalid stored move %s: %s", move, err.Error())
+		}
+	}
+
+	// outcomes that do not follow from the position (resignation, draw agreement, ...) have to be restored
+	outcome := genprotos.GameOutcome(genprotos.GameOutcome_value[fields[fieldOutcome]])
+	if game.Outcome() == chess.NoOutcome {
+		switch outcome {
+		case genprotos.GameOutcome_WHITE_WON:
+			game.Resign(chess.Black)
+		case genprotos.GameOutcome_BLACK_WON:
+			game.Resign(chess.White)
+		case genprotos.GameOutcome_DRAW:
+			game.Draw(chess.DrawOffer)
+		}
+	}
+
+	var records []models.MoveRecord
+	if fields[fieldRecords] != "" {
+		if err := json.Unmarshal([]byte(fields[fieldRecords]), &records); err != nil {
+			return nil, fmt.Errorf("invalid move records: %s", err.Error())
+		}
+	}
+	startedAt, err := strconv.ParseInt(fields[fieldStartedAt], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time: %s", err.Error())
+	}
+
+	whiteClock, err := parseMillis(fields[fieldWhiteClock])
+	if err != nil {
+		return nil, err
+	}
+	blackClock, err := parseMillis(fields[fieldBlackClock])
+	if err != nil {
+		return nil, err
+	}
+	increment, err := parseMillis(fields[fieldIncrement])
+	if err != nil {
+		return nil, err
+	}
+	delay, err := parseMillis(fields[fieldDelay])
+	if err != nil {
+		return nil, err
+	}
+	turnStarted, err := parseMillis(fields[fieldTurnStarted])
+	if err != nil {
+		return nil, err
+	}
+	whiteOffer, err := parseOfferPly(fields[fieldWhiteOffer])
+	if err != nil {
+		return nil, err
+	}
+	blackOffer, err := parseOfferPly(fields[fieldBlackOffer])
+	if err != nil {
+		return nil, err
+	}
+	base, err := parseMillis(fields[fieldBase])
+	if err != nil {
+		return nil, err
+	}
+	takebackPly, _ := strconv.Atoi(fields[fieldTakebackPly])
+	version, err := strconv.ParseInt(fields[fieldVersion], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version: %s", err.Error())
+	}
+
+	return &models.LiveGame{
+		Game:        game,
+		StartFEN:    fields[fieldStartFEN],
+		White:       fields[fieldWhite],
+		Black:       fields[fieldBlack],
+		Base:        base,
+		WhiteClock:  whiteClock,
+		BlackClock:  blackClock,
+		Increment:   increment,
+		Delay:       delay,
+		TurnStarted: time.UnixMilli(turnStarted.Milliseconds()),
+		Records:     records,
+		DrawOffer:   parseColor(fields[fieldDrawOffer]),
+		WhiteOffer:  whiteOffer,
+		BlackOffer:  blackOffer,
+		Rated:       fields[fieldRated] == "true",
+		Takebacks:   fields[fieldTakebacks] == "true",
+		Takeback:    parseColor(fields[fieldTakeback]),
+		TakebackPly: takebackPly,
+		Status:      fields[fieldStatus],
+		Outcome:     outcome,
+		Termination: genprotos.Termination(genprotos.Termination_value[fields[fieldTermination]]),
+		StartedAt:   time.UnixMilli(startedAt),
+		Version:     version,
+	}, nil
+}
+
+func parseMillis(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid clock value %s: %s", value, err.Error())
+	}
+	return time.Duration(millis) * time.Millisecond, nil
+}
+
+func parseOfferPly(value string) (int, error) {
+	if value == "" {
+		return -1, nil
+	}
+	ply, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid draw offer ply %s: %s", value, err.Error())
+	}
+	return ply, nil
+}
+
+// parseColor parses a colour in FEN notation, anything else is NoColor
+func parseColor(value string) chess.Color {
+	switch value {
+	case chess.White.String():
+		return chess.White
+	case chess.Black.String():
+		return chess.Black
+	}
+	return chess.NoColor
+}
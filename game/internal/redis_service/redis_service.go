@@ -1,46 +1,453 @@
 package redisservice
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
-	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/models"
 )
 
 type RedisStorage struct {
-	Pool *redis.Pool
+	Pool          *redis.Pool
+	channelPrefix string // prefix of the pub/sub channels, games get their own channel under it
 }
 
-func NewRedisStorage(pool *redis.Pool) *RedisStorage {
-	return &RedisStorage{Pool: pool}
+func NewRedisStorage(pool *redis.Pool, channelPrefix string) *RedisStorage {
+	return &RedisStorage{Pool: pool, channelPrefix: channelPrefix}
 }
 
-func (r *RedisStorage) SaveGame(gameID string, game *chess.Game) error {
+// NewPool creates a redis connection pool for the given address
+func NewPool(address string) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     10,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", address)
+		},
+		TestOnBorrow: func(c redis.Conn, t time.Time) error {
+			if time.Since(t) < time.Minute {
+				return nil
+			}
+			_, err := c.Do("PING")
+			return err
+		},
+	}
+}
+
+// Close releases the resources used by the pool
+func (r *RedisStorage) Close() error {
+	return r.Pool.Close()
+}
+
+// maxUpdateAttempts limits how many times UpdateGame retries after a concurrent change
+const maxUpdateAttempts = 5
+
+var (
+	// ErrGameNotFound is returned when there is no live game with the given id
+	ErrGameNotFound = errors.New("game not found")
+	// ErrConcurrentUpdate is returned when the game kept changing under every attempt of UpdateGame
+	ErrConcurrentUpdate = errors.New("game was updated concurrently, please retry")
+)
+
+// clockDeadlinesKey is a sorted set of the games with a running clock, scored by the unix
+// milliseconds at which the side to move runs out of time
+const clockDeadlinesKey = "games:clock_deadlines"
+
+// lastSeenKey is a sorted set of the players of games in progress, members are "<game id>:<player id>"
+// scored by the unix milliseconds the player was last seen at
+const lastSeenKey = "games:last_seen"
+
+// pendingGamesKey is a set of the finished games that could not be archived or rated yet
+const pendingGamesKey = "games:pending"
+
+func gameKey(gameID string) string {
+	return "game:" + gameID
+}
+
+// SaveGame stores the live game as a new version, it does not check for concurrent changes
+func (r *RedisStorage) SaveGame(gameID string, game *models.LiveGame) error {
 	conn := r.Pool.Get()
 	defer conn.Close()
 
-	gameJSON, err := json.Marshal(game)
+	game.Version++
+	fields, err := EncodeLiveGame(game)
 	if err != nil {
 		return err
 	}
+	conn.Send("MULTI")
+	sendSave(conn, gameID, game, fields)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// sendSave queues the commands that store the game and keep its clock deadline up to date
+func sendSave(conn redis.Conn, gameID string, game *models.LiveGame, fields map[string]string) {
+	conn.Send("HSET", redis.Args{gameKey(gameID)}.AddFlat(fields)...)
+	if deadline, ok := clock.Deadline(game); ok {
+		conn.Send("ZADD", clockDeadlinesKey, deadline.UnixMilli(), gameID)
+	} else {
+		conn.Send("ZREM", clockDeadlinesKey, gameID)
+	}
+}
+
+func (r *RedisStorage) GetGame(gameID string) (*models.LiveGame, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	return loadGame(conn, gameID)
+}
+
+// UpdateGame loads the game, applies update to it and saves it back atomically.
+// The key is watched while update runs, if another client changes the game in between
+// update is called again on a fresh copy, so it must not have side effects
+func (r *RedisStorage) UpdateGame(gameID string, update func(game *models.LiveGame) error) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	key := gameKey(gameID)
+	for range maxUpdateAttempts {
+		if _, err := conn.Do("WATCH", key); err != nil {
+			return err
+		}
+
+		game, err := loadGame(conn, gameID)
+		if err == nil {
+			err = update(game)
+		}
+		if err != nil {
+			conn.Do("UNWATCH")
+			return err
+		}
+
+		game.Version++
+		fields, err := EncodeLiveGame(game)
+		if err != nil {
+			conn.Do("UNWATCH")
+			return err
+		}
+
+		conn.Send("MULTI")
+		sendSave(conn, gameID, game, fields)
+		reply, err := conn.Do("EXEC")
+		if err != nil {
+			return err
+		}
+		if reply != nil {
+			return nil
+		}
+		// EXEC was aborted because the game changed after WATCH, try again
+	}
+	return ErrConcurrentUpdate
+}
+
+// ExpireGame lets the live state of a finished game expire after ttl
+func (r *RedisStorage) ExpireGame(gameID string, ttl time.Duration) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("EXPIRE", gameKey(gameID), int64(ttl/time.Second))
+	return err
+}
+
+// AddPendingGame remembers a finished game whose archiving or rating update has to be retried
+func (r *RedisStorage) AddPendingGame(gameID string) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("SADD", pendingGamesKey, gameID)
+	return err
+}
+
+// PendingGames returns up to limit finished games whose archiving or rating update has to be retried
+func (r *RedisStorage) PendingGames(limit int) ([]string, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	return redis.Strings(conn.Do("SRANDMEMBER", pendingGamesKey, limit))
+}
 
-	_, err = conn.Do("SET", "game:"+gameID, gameJSON)
+// RemovePendingGame forgets a pending game once it is archived and rated
+func (r *RedisStorage) RemovePendingGame(gameID string) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("SREM", pendingGamesKey, gameID)
 	return err
 }
 
-func (r *RedisStorage) GetGame(gameID string) (*chess.Game, error) {
+// ExpiredClocks returns up to limit games whose side to move has run out of time by now
+func (r *RedisStorage) ExpiredClocks(now time.Time, limit int) ([]string, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	return redis.Strings(conn.Do("ZRANGEBYSCORE", clockDeadlinesKey, "-inf", now.UnixMilli(), "LIMIT", 0, limit))
+}
+
+// ClearClockDeadline forgets the clock deadline of a game that no longer exists
+func (r *RedisStorage) ClearClockDeadline(gameID string) error {
 	conn := r.Pool.Get()
 	defer conn.Close()
 
-	gameJSON, err := redis.String(conn.Do("GET", "game:"+gameID))
+	_, err := conn.Do("ZREM", clockDeadlinesKey, gameID)
+	return err
+}
+
+func loadGame(conn redis.Conn, gameID string) (*models.LiveGame, error) {
+	fields, err := redis.StringMap(conn.Do("HGETALL", gameKey(gameID)))
 	if err != nil {
 		return nil, err
 	}
+	if len(fields) == 0 {
+		return nil, ErrGameNotFound
+	}
+	return DecodeLiveGame(fields)
+}
+
+func presenceMember(gameID, playerID string) string {
+	return gameID + ":" + playerID
+}
+
+func awayKey(gameID, playerID string) string {
+	return gameKey(gameID) + ":away:" + playerID
+}
+
+// TrackPresence starts tracking the presence of the players of a new game, they count as seen at the given time
+func (r *RedisStorage) TrackPresence(gameID string, players []string, at time.Time) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
 
-	var game chess.Game
-	if err := json.Unmarshal([]byte(gameJSON), &game); err != nil {
+	args := redis.Args{lastSeenKey}
+	for _, player := range players {
+		args = args.Add(at.UnixMilli(), presenceMember(gameID, player))
+	}
+	_, err := conn.Do("ZADD", args...)
+	return err
+}
+
+// TouchPresence records that the player was seen at the given time. It reports whether the player
+// is tracked in the game at all and whether the player was marked away before
+func (r *RedisStorage) TouchPresence(gameID, playerID string, at time.Time) (tracked, returned bool, err error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	// XX keeps finished games from being tracked again
+	conn.Send("ZADD", lastSeenKey, "XX", at.UnixMilli(), presenceMember(gameID, playerID))
+	conn.Send("ZSCORE", lastSeenKey, presenceMember(gameID, playerID))
+	conn.Send("DEL", awayKey(gameID, playerID))
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return false, false, err
+	}
+	deleted, err := redis.Int64(replies[2], nil)
+	if err != nil {
+		return false, false, err
+	}
+	return replies[1] != nil, deleted == 1, nil
+}
+
+// LastSeen returns when the player of the game was last seen, it reports false if the player is not tracked
+func (r *RedisStorage) LastSeen(gameID, playerID string) (time.Time, bool, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	millis, err := redis.Int64(conn.Do("ZSCORE", lastSeenKey, presenceMember(gameID, playerID)))
+	if err == redis.ErrNil {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.UnixMilli(millis), true, nil
+}
+
+// AbsentPlayers returns up to limit game and player id pairs of players not seen since before
+func (r *RedisStorage) AbsentPlayers(before time.Time, limit int) ([][2]string, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	members, err := redis.Strings(conn.Do("ZRANGEBYSCORE", lastSeenKey, "-inf", "("+strconv.FormatInt(before.UnixMilli(), 10), "LIMIT", 0, limit))
+	if err != nil {
 		return nil, err
 	}
+	absent := make([][2]string, 0, len(members))
+	for _, member := range members {
+		if gameID, playerID, ok := strings.Cut(member, ":"); ok {
+			absent = append(absent, [2]string{gameID, playerID})
+		}
+	}
+	return absent, nil
+}
+
+// MarkAway remembers for ttl that the player was announced as away and reports whether it was not marked already
+func (r *RedisStorage) MarkAway(gameID, playerID string, ttl time.Duration) (bool, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	reply, err := conn.Do("SET", awayKey(gameID, playerID), 1, "NX", "PX", ttl.Milliseconds())
+	return reply != nil, err
+}
+
+// ForgetPresence stops tracking the presence of the players of a finished game
+func (r *RedisStorage) ForgetPresence(gameID string, players []string) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	for _, player := range players {
+		conn.Send("ZREM", lastSeenKey, presenceMember(gameID, player))
+		conn.Send("DEL", awayKey(gameID, player))
+	}
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+func exportsKey(playerID string) string {
+	return "player:" + playerID + ":exports"
+}
+
+// CountExport counts an export of the player's games and returns the number of exports within the window,
+// the window starts with the first export counted in it
+func (r *RedisStorage) CountExport(playerID string, window time.Duration) (int, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	key := exportsKey(playerID)
+	conn.Send("MULTI")
+	conn.Send("SET", key, 0, "NX", "PX", window.Milliseconds())
+	conn.Send("INCR", key)
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+	return redis.Int(replies[1], nil)
+}
+
+// spectatorsKey is a sorted set of the spectators of the game scored by the unix milliseconds they were last seen at
+func spectatorsKey(gameID string) string {
+	return gameKey(gameID) + ":spectators"
+}
+
+// AddSpectator records that the spectator watches the game at the given time, spectators not seen within timeout
+// are dropped. It returns the number of spectators and whether the spectator is new
+func (r *RedisStorage) AddSpectator(gameID, spectatorID string, at time.Time, timeout time.Duration) (int, bool, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	key := spectatorsKey(gameID)
+	conn.Send("MULTI")
+	conn.Send("ZREMRANGEBYSCORE", key, "-inf", at.Add(-timeout).UnixMilli())
+	conn.Send("ZADD", key, at.UnixMilli(), spectatorID)
+	conn.Send("ZCARD", key)
+	// the set disappears with the last spectator, even if no one removes it
+	conn.Send("PEXPIRE", key, timeout.Milliseconds())
+	replies, err := redis.Int64s(conn.Do("EXEC"))
+	if err != nil {
+		return 0, false, err
+	}
+	return int(replies[2]), replies[1] == 1, nil
+}
+
+// RemoveSpectator drops the spectator from the game and returns the number of spectators left
+func (r *RedisStorage) RemoveSpectator(gameID, spectatorID string, at time.Time, timeout time.Duration) (int, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	key := spectatorsKey(gameID)
+	conn.Send("MULTI")
+	conn.Send("ZREM", key, spectatorID)
+	conn.Send("ZCOUNT", key, at.Add(-timeout).UnixMilli(), "+inf")
+	replies, err := redis.Int64s(conn.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+	return int(replies[1]), nil
+}
+
+// CountSpectators returns the number of spectators seen within timeout of the given time
+func (r *RedisStorage) CountSpectators(gameID string, at time.Time, timeout time.Duration) (int, error) {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	return redis.Int(conn.Do("ZCOUNT", spectatorsKey(gameID), at.Add(-timeout).UnixMilli(), "+inf"))
+}
+
+// GameChannel returns the pub/sub channel the events of the game are published on
+func (r *RedisStorage) GameChannel(gameID string) string {
+	return r.channelPrefix + ":game:" + gameID
+}
+
+// PlayerChannel returns the pub/sub channel the messages for the player are published on
+func (r *RedisStorage) PlayerChannel(playerID string) string {
+	return r.channelPrefix + ":player:" + playerID
+}
+
+// Publish sends the payload to every subscriber of the channel
+func (r *RedisStorage) Publish(channel string, payload []byte) error {
+	conn := r.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("PUBLISH", channel, payload)
+	return err
+}
+
+// Subscribe delivers the messages published on the channel until ctx is done,
+// the returned channel is closed once the subscription ends. It returns once Redis confirmed the subscription,
+// every message published after that is delivered
+func (r *RedisStorage) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	conn := r.Pool.Get()
+	psc := redis.PubSubConn{Conn: conn}
+	if err := psc.Subscribe(channel); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	switch v := psc.Receive().(type) {
+	case redis.Subscription:
+	case error:
+		conn.Close()
+		return nil, v
+	default:
+		conn.Close()
+		return nil, fmt.Errorf("unexpected reply to subscribe: %v", v)
+	}
+
+	messages := make(chan []byte, 16)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			psc.Unsubscribe()
+		case <-done:
+		}
+	}()
+
+	go func() {
+		defer close(messages)
+		defer conn.Close()
+		defer close(done)
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				select {
+				case messages <- v.Data:
+				case <-ctx.Done():
+					return
+				}
+			case redis.Subscription:
+				if v.Count == 0 {
+					return
+				}
+			case error:
+				return
+			}
+		}
+	}()
 
-	return &game, nil
+	return messages, nil
 }
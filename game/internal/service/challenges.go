@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g *GameService) CreateChallenge(ctx context.Context, req *genprotos.CreateChallengeRequest) (*genprotos.Challenge, error) {
+	if req.ChallengerId == "" || req.ChallengedId == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenger_id and challenged_id are required")
+	}
+	duration, ok := g.storage.GameDuration(req.Duration)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "there are no %d minute games", req.Duration)
+	}
+	challenge, err := g.storage.CreateChallenge(ctx, req.ChallengerId, req.ChallengedId, duration, req.Rated, req.Color)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toChallenge(challenge), nil
+}
+
+func (g *GameService) AcceptChallenge(ctx context.Context, req *genprotos.ChallengeActionRequest) (*genprotos.AcceptChallengeResponse, error) {
+	if err := validateChallengeAction(req); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.AcceptChallenge(ctx, req.ChallengeId, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// balanced together with the colours of matchmaking games
+	g.gameService.RecordGameColors(ctx, resp.GameId)
+	return resp, nil
+}
+
+func (g *GameService) DeclineChallenge(ctx context.Context, req *genprotos.ChallengeActionRequest) (*genprotos.DeclineChallengeResponse, error) {
+	if err := validateChallengeAction(req); err != nil {
+		return nil, err
+	}
+	if err := g.storage.DeclineChallenge(ctx, req.ChallengeId, req.PlayerId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &genprotos.DeclineChallengeResponse{}, nil
+}
+
+func (g *GameService) ListIncomingChallenges(ctx context.Context, req *genprotos.ListIncomingChallengesRequest) (*genprotos.ListIncomingChallengesResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	challenges, err := g.storage.IncomingChallenges(ctx, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &genprotos.ListIncomingChallengesResponse{Challenges: make([]*genprotos.Challenge, len(challenges))}
+	for i, challenge := range challenges {
+		resp.Challenges[i] = toChallenge(challenge)
+	}
+	return resp, nil
+}
+
+// WatchChallenges streams the answers to the challenges the player sent until the client leaves
+func (g *GameService) WatchChallenges(req *genprotos.WatchChallengesRequest, stream grpc.ServerStreamingServer[genprotos.ChallengeAnswer]) error {
+	if req.PlayerId == "" {
+		return status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	ctx := stream.Context()
+
+	answers, err := g.storage.SubscribeChallengeAnswers(ctx, req.PlayerId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not subscribe to the challenges: %s", err.Error())
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case answer, ok := <-answers:
+			if !ok {
+				return status.Error(codes.Unavailable, "lost the answers to the challenges, please watch them again")
+			}
+			if err := stream.Send(answer); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toChallenge(challenge *models.Challenge) *genprotos.Challenge {
+	return &genprotos.Challenge{
+		ChallengeId:  challenge.ID,
+		ChallengerId: challenge.Challenger,
+		ChallengedId: challenge.Challenged,
+		Duration:     int32(challenge.Duration),
+		Rated:        challenge.Rated,
+		Color:        challenge.Color,
+		ExpiresAtMs:  challenge.ExpiresAt.UnixMilli(),
+	}
+}
+
+func validateChallengeAction(req *genprotos.ChallengeActionRequest) error {
+	if req.ChallengeId == "" || req.PlayerId == "" {
+		return status.Error(codes.InvalidArgument, "challenge_id and player_id are required")
+	}
+	return nil
+}
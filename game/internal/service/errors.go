@@ -0,0 +1,32 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus converts an error returned by the storage into a gRPC status error
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrGameNotFound),
+		errors.Is(err, storage.ErrChallengeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, storage.ErrInvalidChallenge),
+		errors.Is(err, storage.ErrInvalidCursor),
+		errors.Is(err, storage.ErrInvalidPGN):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, storage.ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, storage.ErrNotParticipant):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, storage.ErrNotYourTurn),
+		errors.Is(err, storage.ErrGameOver),
+		errors.Is(err, storage.ErrActionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g *GameService) ResignGame(ctx context.Context, req *genprotos.GameActionRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.Resign(ctx, req.GameId, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) OfferDraw(ctx context.Context, req *genprotos.GameActionRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.OfferDraw(ctx, req.GameId, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) RespondDraw(ctx context.Context, req *genprotos.RespondDrawRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.RespondDraw(ctx, req.GameId, req.PlayerId, req.Accept)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) AbortGame(ctx context.Context, req *genprotos.GameActionRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.AbortGame(ctx, req.GameId, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) RequestTakeback(ctx context.Context, req *genprotos.TakebackRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.RequestTakeback(ctx, req.GameId, req.PlayerId, req.FullMove)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) RespondTakeback(ctx context.Context, req *genprotos.RespondTakebackRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.RespondTakeback(ctx, req.GameId, req.PlayerId, req.Accept)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) Heartbeat(ctx context.Context, req *genprotos.GameActionRequest) (*genprotos.HeartbeatResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.Heartbeat(ctx, req.GameId, req.PlayerId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+func (g *GameService) ClaimAbandonment(ctx context.Context, req *genprotos.ClaimAbandonmentRequest) (*genprotos.GameActionResponse, error) {
+	if err := validateAction(req.GameId, req.PlayerId); err != nil {
+		return nil, err
+	}
+	resp, err := g.storage.ClaimAbandonment(ctx, req.GameId, req.PlayerId, req.Draw)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// validateAction checks the fields every game action needs
+func validateAction(gameID, playerID string) error {
+	if gameID == "" || playerID == "" {
+		return status.Error(codes.InvalidArgument, "game_id and player_id are required")
+	}
+	return nil
+}
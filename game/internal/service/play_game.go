@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// presenceInterval is how often a player connected to PlayGame is marked as present,
+// it has to be well below the abandonment grace period
+const presenceInterval = 5 * time.Second
+
+// PlayGame lets a player act in a game and pushes every event of the game back to the player,
+// the first message of the stream has to join the game
+func (g *GameService) PlayGame(stream grpc.BidiStreamingServer[genprotos.PlayGameRequest, genprotos.GameEvent]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := first.GetJoin()
+	if join == nil || join.GameId == "" || join.PlayerId == "" {
+		return status.Error(codes.InvalidArgument, "the first message has to join a game")
+	}
+
+	players, err := g.storage.GetPlayers(ctx, join.GameId)
+	if err != nil {
+		return toStatus(err)
+	}
+	if !slices.Contains(players, join.PlayerId) {
+		return toStatus(storage.ErrNotParticipant)
+	}
+
+	// events go through redis so that players connected to different instances see each other
+	events, err := g.storage.SubscribeGame(ctx, join.GameId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not subscribe to the game: %s", err.Error())
+	}
+
+	var sendMutex sync.Mutex
+	send := func(event *genprotos.GameEvent) error {
+		sendMutex.Lock()
+		defer sendMutex.Unlock()
+		return stream.Send(event)
+	}
+
+	// being connected to the stream keeps the player present in the game
+	go g.keepPresent(ctx, join.GameId, join.PlayerId)
+
+	// actions are received apart from the events, so that the stream ends as soon as either side of it does
+	received := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				received <- err
+				return
+			}
+
+			if err := g.handleGameAction(ctx, join, req); err != nil {
+				rejected := &genprotos.GameEvent{
+					GameId: join.GameId,
+					Event:  &genprotos.GameEvent_Rejected{Rejected: &genprotos.ActionRejected{Reason: err.Error()}},
+				}
+				if err := send(rejected); err != nil {
+					received <- err
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-received:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "lost the events of the game, please join it again")
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// keepPresent sends heartbeats for the player until ctx is done
+func (g *GameService) keepPresent(ctx context.Context, gameID, playerID string) {
+	ticker := time.NewTicker(presenceInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := g.storage.Heartbeat(ctx, gameID, playerID); errors.Is(err, storage.ErrGameNotFound) {
+			// the game is over, there is nothing to be present in anymore
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleGameAction applies a single action sent on the PlayGame stream
+func (g *GameService) handleGameAction(ctx context.Context, join *genprotos.JoinGame, req *genprotos.PlayGameRequest) error {
+	switch action := req.Action.(type) {
+	case *genprotos.PlayGameRequest_Move:
+		resp, err := g.storage.MakeMove(ctx, &genprotos.MakeMoveRequest{
+			GameId:   join.GameId,
+			PlayerId: join.PlayerId,
+			Move:     action.Move,
+		})
+		if err != nil {
+			return err
+		}
+		if !resp.Success {
+			return errors.New(resp.Message)
+		}
+		return nil
+	case *genprotos.PlayGameRequest_Resign:
+		_, err := g.storage.Resign(ctx, join.GameId, join.PlayerId)
+		return err
+	case *genprotos.PlayGameRequest_OfferDraw:
+		_, err := g.storage.OfferDraw(ctx, join.GameId, join.PlayerId)
+		return err
+	case *genprotos.PlayGameRequest_DrawResponse:
+		_, err := g.storage.RespondDraw(ctx, join.GameId, join.PlayerId, action.DrawResponse.Accept)
+		return err
+	case *genprotos.PlayGameRequest_Abort:
+		_, err := g.storage.AbortGame(ctx, join.GameId, join.PlayerId)
+		return err
+	case *genprotos.PlayGameRequest_RequestTakeback:
+		_, err := g.storage.RequestTakeback(ctx, join.GameId, join.PlayerId, action.RequestTakeback.FullMove)
+		return err
+	case *genprotos.PlayGameRequest_TakebackResponse:
+		_, err := g.storage.RespondTakeback(ctx, join.GameId, join.PlayerId, action.TakebackResponse.Accept)
+		return err
+	case *genprotos.PlayGameRequest_Join:
+		return errors.New("already joined the game")
+	default:
+		return errors.New("unknown action")
+	}
+}
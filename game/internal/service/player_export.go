@@ -0,0 +1,44 @@
+package service
+
+import (
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportPlayerGames streams every finished game of the player in PGN or NDJSON, one message per game.
+// Players can only start a limited number of exports within the export window
+func (g *GameService) ExportPlayerGames(req *genprotos.ExportPlayerGamesRequest, stream grpc.ServerStreamingServer[genprotos.ExportedGame]) error {
+	if req.PlayerId == "" {
+		return status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	if _, ok := genprotos.ExportFormat_name[int32(req.Format)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown format %d", req.Format)
+	}
+	since, until, err := startedRange(req.SinceMs, req.UntilMs)
+	if err != nil {
+		return err
+	}
+
+	// errors of the stream are returned as they are, they are not the storage's
+	var sendErr error
+	err = g.storage.ExportPlayerGames(
+		stream.Context(),
+		storage.GameFilter{PlayerID: req.PlayerId, Since: since, Until: until},
+		req.OldestFirst,
+		req.Format,
+		func(game *genprotos.ExportedGame) error {
+			sendErr = stream.Send(game)
+			return sendErr
+		},
+	)
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
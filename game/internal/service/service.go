@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/ruziba3vich/chess_app/internal/game_service"
 	"github.com/ruziba3vich/chess_app/internal/genprotos"
 	"github.com/ruziba3vich/chess_app/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GameService struct {
@@ -14,19 +20,261 @@ type GameService struct {
 	gameService *game_service.MatchmakingService
 }
 
-func NewGameService(storage *storage.Storage) *GameService {
+func NewGameService(storage *storage.Storage, gameService *game_service.MatchmakingService) *GameService {
 	return &GameService{
-		storage: storage,
+		storage:     storage,
+		gameService: gameService,
 	}
 }
 
-func (g *GameService) CreateGame(ctx context.Context, req *genprotos.CreateGameRequest) error {
-	return g.gameService.AddPlayer(ctx, req.PlayerId, float64(req.PlayerRank), req.Duration, make(chan string))
+func (g *GameService) CreateGame(ctx context.Context, req *genprotos.CreateGameRequest) (*genprotos.CreateGameResponse, error) {
+	queue, duration, err := g.matchQueue(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rating, err := g.storage.GetRating(ctx, req.PlayerId, duration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not load the rating: %s", err.Error())
+	}
+
+	// buffered so that the matchmaking worker never blocks on delivery
+	playerChannel := make(chan *genprotos.MatchEvent, 1)
+	if err := g.gameService.AddPlayer(ctx, req.PlayerId, rating.Rating, queue, req.PreferredColor, playerChannel); err != nil {
+		return nil, joinError(err)
+	}
+
+	matched, err := g.gameService.WaitForMatch(ctx, req.PlayerId, queue, playerChannel, nil)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, status.Error(codes.DeadlineExceeded, "could not find an opponent, please retry")
+	case errors.Is(err, context.Canceled), errors.Is(err, game_service.ErrSearchCancelled):
+		return nil, status.Error(codes.Canceled, "search was cancelled")
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &genprotos.CreateGameResponse{GameId: matched.GameId}, nil
+}
+
+// FindMatch streams the search progress to the player until an opponent is found or the search times out
+func (g *GameService) FindMatch(req *genprotos.CreateGameRequest, stream grpc.ServerStreamingServer[genprotos.MatchEvent]) error {
+	queue, duration, err := g.matchQueue(req)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	rating, err := g.storage.GetRating(ctx, req.PlayerId, duration)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not load the rating: %s", err.Error())
+	}
+
+	playerChannel := make(chan *genprotos.MatchEvent, 1)
+	if err := g.gameService.AddPlayer(ctx, req.PlayerId, rating.Rating, queue, req.PreferredColor, playerChannel); err != nil {
+		return joinError(err)
+	}
+
+	queued := &genprotos.MatchEvent{
+		Event: &genprotos.MatchEvent_Queued{Queued: &genprotos.Queued{Duration: req.Duration}},
+	}
+	if err := stream.Send(queued); err != nil {
+		if removeErr := g.gameService.RemovePlayer(context.Background(), req.PlayerId, queue); removeErr != nil {
+			return status.Error(codes.Internal, removeErr.Error())
+		}
+		return err
+	}
+
+	matched, err := g.gameService.WaitForMatch(ctx, req.PlayerId, queue, playerChannel, stream.Send)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		return stream.Send(&genprotos.MatchEvent{
+			Event: &genprotos.MatchEvent_Timeout{Timeout: &genprotos.Timeout{}},
+		})
+	case errors.Is(err, game_service.ErrSearchCancelled):
+		return stream.Send(&genprotos.MatchEvent{
+			Event: &genprotos.MatchEvent_Cancelled{Cancelled: &genprotos.Cancelled{}},
+		})
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "could not find an opponent, please retry")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "search was cancelled")
+	case err != nil:
+		return err
+	}
+
+	return stream.Send(&genprotos.MatchEvent{
+		Event: &genprotos.MatchEvent_Matched{Matched: matched},
+	})
+}
+
+// CancelSearch takes the player out of every matchmaking queue and ends the player's open search
+func (g *GameService) CancelSearch(ctx context.Context, req *genprotos.CancelSearchRequest) (*genprotos.CancelSearchResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	cancelled, err := g.gameService.CancelSearch(ctx, req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &genprotos.CancelSearchResponse{Cancelled: cancelled}, nil
+}
+
+// GetRatings returns the player's ratings, one for each duration the player has played rated games of
+func (g *GameService) GetRatings(ctx context.Context, req *genprotos.GetRatingsRequest) (*genprotos.GetRatingsResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	ratings, err := g.storage.GetRatings(ctx, req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &genprotos.GetRatingsResponse{Ratings: make([]*genprotos.PlayerRating, len(ratings))}
+	for i, rating := range ratings {
+		resp.Ratings[i] = &genprotos.PlayerRating{
+			Duration:   int32(rating.Duration),
+			Rating:     rating.Rating,
+			Deviation:  rating.Deviation,
+			Volatility: rating.Volatility,
+			Games:      int32(rating.Games),
+		}
+	}
+	return resp, nil
 }
+
 func (g *GameService) GetGameStats(ctx context.Context, req *genprotos.GetGameStatsRequest) (*genprotos.GetGameStatsResponse, error) {
 	return g.storage.GetGameStats(ctx, req.GameId)
 }
 
+// GetGame returns the full state of a game, whether it is in progress or archived
+func (g *GameService) GetGame(ctx context.Context, req *genprotos.GetGameRequest) (*genprotos.GetGameResponse, error) {
+	if req.GameId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+	}
+	resp, err := g.storage.GetGame(ctx, req.GameId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// page sizes of ListGames
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListGames returns a page of the player's games matching the filters, the newest first
+func (g *GameService) ListGames(ctx context.Context, req *genprotos.ListGamesRequest) (*genprotos.ListGamesResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	if req.PageSize < 0 || req.PageSize > maxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 1 and %d", maxPageSize)
+	}
+	since, until, err := startedRange(req.SinceMs, req.UntilMs)
+	if err != nil {
+		return nil, err
+	}
+	var duration int8
+	if req.Duration != 0 {
+		var ok bool
+		if duration, ok = g.storage.GameDuration(req.Duration); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "there are no %d minute games", req.Duration)
+		}
+	}
+
+	filter := storage.GameFilter{
+		PlayerID:   req.PlayerId,
+		OpponentID: req.OpponentId,
+		Color:      req.Color,
+		Result:     req.Result,
+		Duration:   duration,
+		Rated:      req.Rated,
+		Since:      since,
+		Until:      until,
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	games, next, err := g.storage.ListGames(ctx, filter, req.Cursor, pageSize)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &genprotos.ListGamesResponse{Games: games, NextCursor: next}, nil
+}
+
+// startedRange validates the range of start times of the games asked for, an unset bound is left zero
+func startedRange(sinceMs, untilMs int64) (since, until time.Time, err error) {
+	if sinceMs != 0 && untilMs != 0 && sinceMs >= untilMs {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "since_ms must be before until_ms")
+	}
+	if sinceMs != 0 {
+		since = time.UnixMilli(sinceMs)
+	}
+	if untilMs != 0 {
+		until = time.UnixMilli(untilMs)
+	}
+	return since, until, nil
+}
+
+// ExportPGN renders a live or archived game in PGN
+func (g *GameService) ExportPGN(ctx context.Context, req *genprotos.ExportPGNRequest) (*genprotos.ExportPGNResponse, error) {
+	if req.GameId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+	}
+	pgn, err := g.storage.ExportPGN(ctx, req.GameId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &genprotos.ExportPGNResponse{Pgn: pgn}, nil
+}
+
+// ImportPGN stores the games of a PGN as imported games, nothing is imported if any game is invalid
+func (g *GameService) ImportPGN(ctx context.Context, req *genprotos.ImportPGNRequest) (*genprotos.ImportPGNResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	if strings.TrimSpace(req.Pgn) == "" {
+		return nil, status.Error(codes.InvalidArgument, "pgn is required")
+	}
+	ids, err := g.storage.ImportPGN(ctx, req.PlayerId, req.Pgn)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &genprotos.ImportPGNResponse{GameIds: ids}, nil
+}
+
 func (g *GameService) MakeMove(ctx context.Context, req *genprotos.MakeMoveRequest) (*genprotos.MakeMoveResponse, error) {
-	return g.storage.MakeMove(ctx, req)
+	resp, err := g.storage.MakeMove(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// matchQueue validates the search request and returns the queue the player joins and the duration of its games,
+// there is a queue only for the durations of the configured time controls
+func (g *GameService) matchQueue(req *genprotos.CreateGameRequest) (game_service.Queue, int8, error) {
+	if req.PlayerId == "" {
+		return game_service.Queue{}, 0, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	duration, ok := g.storage.GameDuration(req.Duration)
+	if !ok {
+		return game_service.Queue{}, 0, status.Errorf(codes.InvalidArgument, "there are no %d minute games", req.Duration)
+	}
+	if !req.Casual && req.PreferredColor != genprotos.Color_NO_COLOR {
+		return game_service.Queue{}, 0, status.Error(codes.InvalidArgument, "a colour can only be chosen in casual games")
+	}
+	return game_service.Queue{Duration: req.Duration, Casual: req.Casual}, duration, nil
+}
+
+func joinError(err error) error {
+	if errors.Is(err, game_service.ErrAlreadySearching) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return status.Errorf(codes.Internal, "could not join the queue: %s", err.Error())
 }
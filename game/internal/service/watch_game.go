@@ -0,0 +1,189 @@
+package service
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// clockTickInterval is how often spectators receive the running clocks
+const clockTickInterval = time.Second
+
+// WatchGame sends a snapshot of the game followed by every event of it until the game ends,
+// the running clocks are sent every clockTickInterval in between
+func (g *GameService) WatchGame(req *genprotos.WatchGameRequest, stream grpc.ServerStreamingServer[genprotos.GameEvent]) error {
+	if req.GameId == "" {
+		return status.Error(codes.InvalidArgument, "game_id is required")
+	}
+	ctx := stream.Context()
+
+	// subscribed before the snapshot is taken so that no event falls between the two,
+	// the events the snapshot already has are left out by the clock
+	events, err := g.storage.SubscribeGame(ctx, req.GameId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not subscribe to the game: %s", err.Error())
+	}
+	snapshot, err := g.storage.GameSnapshot(ctx, req.GameId)
+	if err != nil {
+		return toStatus(err)
+	}
+	if err := stream.Send(&genprotos.GameEvent{
+		GameId: req.GameId,
+		Event:  &genprotos.GameEvent_Snapshot{Snapshot: snapshot},
+	}); err != nil {
+		return err
+	}
+	if snapshot.Status != models.StatusOngoing {
+		return nil
+	}
+
+	spectatorID := strconv.FormatUint(rand.Uint64(), 36)
+	if err := g.storage.AddSpectator(req.GameId, spectatorID); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	// a spectator that could not be removed stops being counted once it is not refreshed anymore
+	defer g.storage.RemoveSpectator(req.GameId, spectatorID)
+
+	clocks := NewWatchedClock(snapshot, time.Now())
+	ticker := time.NewTicker(clockTickInterval)
+	defer ticker.Stop()
+	refresh := time.NewTicker(storage.SpectatorRefresh)
+	defer refresh.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "lost the events of the game, please watch it again")
+			}
+			if !clocks.Apply(event, time.Now()) {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			if event.GetResult() != nil {
+				return nil
+			}
+		case <-ticker.C:
+			tick, running := clocks.Tick(time.Now())
+			if !running {
+				continue
+			}
+			if err := stream.Send(&genprotos.GameEvent{
+				GameId: req.GameId,
+				Event:  &genprotos.GameEvent_ClockTick{ClockTick: tick},
+			}); err != nil {
+				return err
+			}
+		case <-refresh.C:
+			if err := g.storage.AddSpectator(req.GameId, spectatorID); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+}
+
+// WatchedClock follows the clocks of a game from its events, so that spectators see them run
+// without reading the game from Redis on every tick
+type WatchedClock struct {
+	white, black time.Duration // remaining times as of since
+	delay        time.Duration // spent from every move before the clock starts running
+	pendingDelay time.Duration // part of the delay not yet spent as of since
+	turn         genprotos.Color
+	plies        int
+	version      int64 // version of the game the clocks are as of
+	since        time.Time
+	over         bool
+}
+
+// NewWatchedClock starts following the clocks of the snapshot, whose times have the delay of the current move applied already
+func NewWatchedClock(snapshot *genprotos.GameSnapshot, now time.Time) *WatchedClock {
+	return &WatchedClock{
+		white:   time.Duration(snapshot.WhiteClockMs) * time.Millisecond,
+		black:   time.Duration(snapshot.BlackClockMs) * time.Millisecond,
+		delay:   time.Duration(snapshot.DelayMs) * time.Millisecond,
+		turn:    snapshot.Turn,
+		plies:   len(snapshot.Moves),
+		version: snapshot.Version,
+		since:   now,
+	}
+}
+
+// Apply updates the clocks with an event of the game. It reports false for moves and takebacks
+// the clocks already have, i.e. those published before the snapshot was taken
+func (c *WatchedClock) Apply(event *genprotos.GameEvent, now time.Time) bool {
+	switch e := event.Event.(type) {
+	case *genprotos.GameEvent_MoveMade:
+		if e.MoveMade.Version <= c.version {
+			return false
+		}
+		c.version = e.MoveMade.Version
+		c.white = time.Duration(e.MoveMade.WhiteClockMs) * time.Millisecond
+		c.black = time.Duration(e.MoveMade.BlackClockMs) * time.Millisecond
+		c.turn = genprotos.Color_WHITE
+		if e.MoveMade.Move.GetIsWhite() {
+			c.turn = genprotos.Color_BLACK
+		}
+		c.plies++
+	case *genprotos.GameEvent_TakenBack:
+		if e.TakenBack.Version <= c.version {
+			return false
+		}
+		c.version = e.TakenBack.Version
+		c.white = time.Duration(e.TakenBack.WhiteClockMs) * time.Millisecond
+		c.black = time.Duration(e.TakenBack.BlackClockMs) * time.Millisecond
+		c.turn = fenTurn(e.TakenBack.Fen)
+		c.plies -= int(e.TakenBack.Plies)
+	case *genprotos.GameEvent_Result:
+		c.white, c.black = c.remaining(now)
+		c.over = true
+	default:
+		return true
+	}
+	c.since = now
+	c.pendingDelay = c.delay
+	return true
+}
+
+// Tick returns the clocks at now, it reports false if no clock is running
+func (c *WatchedClock) Tick(now time.Time) (*genprotos.ClockTick, bool) {
+	if c.over || c.plies < 2 {
+		return nil, false
+	}
+	white, black := c.remaining(now)
+	return &genprotos.ClockTick{WhiteClockMs: white.Milliseconds(), BlackClockMs: black.Milliseconds()}, true
+}
+
+func (c *WatchedClock) remaining(now time.Time) (white, black time.Duration) {
+	white, black = c.white, c.black
+	if c.over || c.plies < 2 {
+		return white, black
+	}
+	elapsed := now.Sub(c.since) - c.pendingDelay
+	if elapsed <= 0 {
+		return white, black
+	}
+	if c.turn == genprotos.Color_BLACK {
+		return white, max(black-elapsed, 0)
+	}
+	return max(white-elapsed, 0), black
+}
+
+// fenTurn returns the side to move of a position in FEN
+func fenTurn(fen string) genprotos.Color {
+	if fields := strings.Fields(fen); len(fields) > 1 && fields[1] == "b" {
+		return genprotos.Color_BLACK
+	}
+	return genprotos.Color_WHITE
+}
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrChallengeNotFound is returned when the challenge does not exist, expired or was answered already
+	ErrChallengeNotFound = errors.New("challenge not found")
+	// ErrInvalidChallenge is returned when a challenge can not be created with the given settings, it is wrapped with the reason
+	ErrInvalidChallenge = errors.New("invalid challenge")
+)
+
+// CreateChallenge invites the challenged player to a game, the challenge can be accepted until the challenge ttl passes
+func (s *Storage) CreateChallenge(
+	ctx context.Context,
+	challenger, challenged string,
+	duration int8,
+	rated bool,
+	color genprotos.Color,
+) (*models.Challenge, error) {
+	if challenger == challenged {
+		return nil, fmt.Errorf("%w: players can not challenge themselves", ErrInvalidChallenge)
+	}
+	if _, ok := s.gameConfig.TimeControls[duration]; !ok {
+		return nil, fmt.Errorf("%w: there are no %d minute games", ErrInvalidChallenge, duration)
+	}
+
+	now := time.Now()
+	challenge := &models.Challenge{
+		ID:         primitive.NewObjectID().Hex(),
+		Challenger: challenger,
+		Challenged: challenged,
+		Duration:   duration,
+		Rated:      rated,
+		Color:      color,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.gameConfig.ChallengeTTL),
+	}
+	if err := s.redisService.SaveChallenge(challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// AcceptChallenge creates the game of the challenge the same way matchmaking does, only the challenged player can accept.
+// The challenger is sent the game on the player's channel
+func (s *Storage) AcceptChallenge(ctx context.Context, challengeID, playerID string) (*genprotos.AcceptChallengeResponse, error) {
+	challenge, err := s.takeChallenge(challengeID, func(challenge *models.Challenge) bool {
+		return playerID == challenge.Challenged
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	white, black := challenge.Challenger, challenge.Challenged
+	if challenge.Color == genprotos.Color_BLACK || challenge.Color == genprotos.Color_NO_COLOR && rand.IntN(2) == 0 {
+		white, black = black, white
+	}
+
+	// casual games always allow takebacks
+	gameID, err := s.CreateGameStorage(ctx, white, black, GameSettings{
+		Duration:    challenge.Duration,
+		TimeControl: s.gameConfig.TimeControls[challenge.Duration],
+		Rated:       challenge.Rated,
+		Takebacks:   !challenge.Rated || s.gameConfig.RatedTakebacks,
+	})
+	if err != nil {
+		// taken first so that concurrent answers can not create two games, it is put back to be accepted again
+		if time.Now().Before(challenge.ExpiresAt) {
+			if restoreErr := s.redisService.SaveChallenge(challenge); restoreErr != nil {
+				s.logger.Printf("Failed to restore challenge %s: %s", challenge.ID, restoreErr.Error())
+			}
+		}
+		return nil, err
+	}
+
+	// announced like the games created by matchmaking
+	if err := s.redisService.Publish(s.gameConfig.RedisChannel, []byte(fmt.Sprintf("%s:%s:%s", white, black, gameID))); err != nil {
+		s.logger.Println("Error publishing game:", err)
+	}
+
+	challengerColor, color := genprotos.Color_WHITE, genprotos.Color_BLACK
+	if playerID == white {
+		challengerColor, color = color, challengerColor
+	}
+	s.publishAnswer(challenge.Challenger, &genprotos.ChallengeAnswer{
+		ChallengeId: challenge.ID,
+		Accepted:    true,
+		GameId:      gameID,
+		Color:       challengerColor,
+	})
+	return &genprotos.AcceptChallengeResponse{GameId: gameID, Color: color}, nil
+}
+
+// DeclineChallenge removes the challenge, the challenged player declines it and the challenger withdraws it.
+// The challenger is told about a declined challenge on the player's channel
+func (s *Storage) DeclineChallenge(ctx context.Context, challengeID, playerID string) error {
+	challenge, err := s.takeChallenge(challengeID, func(challenge *models.Challenge) bool {
+		return playerID == challenge.Challenged || playerID == challenge.Challenger
+	})
+	if err != nil {
+		return err
+	}
+	if playerID == challenge.Challenged {
+		s.publishAnswer(challenge.Challenger, &genprotos.ChallengeAnswer{ChallengeId: challenge.ID})
+	}
+	return nil
+}
+
+// SubscribeChallengeAnswers delivers the answers to the challenges the player sent until ctx is done
+func (s *Storage) SubscribeChallengeAnswers(ctx context.Context, playerID string) (<-chan *genprotos.ChallengeAnswer, error) {
+	messages, err := s.redisService.Subscribe(ctx, s.redisService.PlayerChannel(playerID))
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make(chan *genprotos.ChallengeAnswer)
+	go func() {
+		defer close(answers)
+		for payload := range messages {
+			var answer genprotos.ChallengeAnswer
+			if err := proto.Unmarshal(payload, &answer); err != nil {
+				s.logger.Println("Error decoding challenge answer:", err)
+				continue
+			}
+			select {
+			case answers <- &answer:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return answers, nil
+}
+
+func (s *Storage) publishAnswer(challengerID string, answer *genprotos.ChallengeAnswer) {
+	payload, err := proto.Marshal(answer)
+	if err != nil {
+		s.logger.Println("Error encoding challenge answer:", err)
+		return
+	}
+	if err := s.redisService.Publish(s.redisService.PlayerChannel(challengerID), payload); err != nil {
+		s.logger.Println("Error publishing challenge answer:", err)
+	}
+}
+
+// IncomingChallenges returns the open challenges the player received, the oldest first
+func (s *Storage) IncomingChallenges(ctx context.Context, playerID string) ([]*models.Challenge, error) {
+	return s.redisService.IncomingChallenges(playerID, time.Now())
+}
+
+// takeChallenge removes the challenge if allowed reports true for it, of concurrent answers only one succeeds
+func (s *Storage) takeChallenge(challengeID string, allowed func(challenge *models.Challenge) bool) (*models.Challenge, error) {
+	challenge, err := s.redisService.GetChallenge(challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, ErrChallengeNotFound
+	}
+	if !allowed(challenge) {
+		return nil, ErrNotParticipant
+	}
+
+	taken, err := s.redisService.TakeChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if !taken {
+		return nil, ErrChallengeNotFound
+	}
+	return challenge, nil
+}
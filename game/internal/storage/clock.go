@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// sweepBatchSize limits how many games are flagged in one round of the sweeper
+const sweepBatchSize = 100
+
+// errClockRunning is returned when a game is checked for flag-fall while the player still has time
+var errClockRunning = errors.New("player still has time on the clock")
+
+// RunClockSweeper flags the players who ran out of time every interval until ctx is done,
+// so games end on time even when nobody sends a move. Finished games that could not be archived
+// or rated are retried in the same rounds. It is safe to run on several replicas
+func (s *Storage) RunClockSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sweepClocks(ctx, now)
+			s.retryPendingGames(ctx)
+		}
+	}
+}
+
+func (s *Storage) sweepClocks(ctx context.Context, now time.Time) {
+	gameIDs, err := s.redisService.ExpiredClocks(now, sweepBatchSize)
+	if err != nil {
+		s.logger.Println("Failed to load expired clocks:", err)
+		return
+	}
+
+	for _, gameID := range gameIDs {
+		err := s.flagGame(ctx, gameID, now)
+		switch {
+		case err == nil, errors.Is(err, errClockRunning), errors.Is(err, ErrGameOver):
+			// the deadline is refreshed or removed with every change of the game
+		case errors.Is(err, ErrGameNotFound):
+			if err := s.redisService.ClearClockDeadline(gameID); err != nil {
+				s.logger.Println("Failed to clear clock deadline:", err)
+			}
+		default:
+			s.logger.Printf("Failed to flag game %s: %s", gameID, err.Error())
+		}
+	}
+}
+
+// flagGame ends the game on time if the side to move has no time left at now
+func (s *Storage) flagGame(ctx context.Context, gameID string, now time.Time) error {
+	_, err := s.endGame(ctx, gameID, func(live *models.LiveGame) error {
+		turn := live.Game.Position().Turn()
+		if !clock.Running(live) || clock.Remaining(live, turn, now) > 0 {
+			return errClockRunning
+		}
+		forfeit(live, turn, genprotos.Termination_TIMEOUT)
+		return nil
+	})
+	return err
+}
+
+// forfeit ends the live game as lost by the player of the given colour (on time, by leaving, ...)
+func forfeit(live *models.LiveGame, color chess.Color, termination genprotos.Termination) {
+	outcome := TimeoutOutcome(live.Game.Position(), color)
+	finishLive(live, outcome, termination)
+	if outcome == genprotos.GameOutcome_DRAW {
+		live.Game.Draw(chess.DrawOffer)
+	} else {
+		live.Game.Resign(color)
+	}
+}
+
+// TimeoutOutcome returns the result of the game the player of the given colour forfeited. It is a draw if
+// the opponent can not mate: nothing but the king left, or a lone knight or bishop against a bare king
+func TimeoutOutcome(pos *chess.Position, flagged chess.Color) genprotos.GameOutcome {
+	winner := flagged.Other()
+	var winnerPieces []chess.PieceType
+	flaggedBare := true
+	for _, piece := range pos.Board().SquareMap() {
+		switch {
+		case piece.Type() == chess.King:
+		case piece.Color() == winner:
+			winnerPieces = append(winnerPieces, piece.Type())
+		default:
+			flaggedBare = false
+		}
+	}
+
+	canMate := len(winnerPieces) > 0
+	if len(winnerPieces) == 1 && flaggedBare {
+		canMate = winnerPieces[0] != chess.Knight && winnerPieces[0] != chess.Bishop
+	}
+	switch {
+	case !canMate:
+		return genprotos.GameOutcome_DRAW
+	case winner == chess.White:
+		return genprotos.GameOutcome_WHITE_WON
+	default:
+		return genprotos.GameOutcome_BLACK_WON
+	}
+}
@@ -7,27 +7,31 @@ import (
 
 	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
 	"github.com/ruziba3vich/chess_app/pkg/config"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type (
 	DB struct {
-		Client          *mongo.Client
-		GamesCollection *mongo.Collection
+		Client            *mongo.Client
+		GamesCollection   *mongo.Collection
+		RatingsCollection *mongo.Collection
 	}
 	Storage struct {
 		database     *DB
 		logger       *log.Logger
 		redisService *redisservice.RedisStorage
+		gameConfig   *config.GameConfig
 	}
 )
 
-func NewStorage(database *DB, logger *log.Logger, redisService *redisservice.RedisStorage) *Storage {
+func NewStorage(database *DB, logger *log.Logger, redisService *redisservice.RedisStorage, cfg *config.Config) *Storage {
 	return &Storage{
 		database:     database,
 		logger:       logger,
 		redisService: redisService,
+		gameConfig:   cfg.GameConfig,
 	}
 }
 
@@ -44,12 +48,52 @@ func ConnectDB(cfg *config.Config, ctx context.Context) (*DB, error) {
 		return nil, fmt.Errorf("failed to ping MongoDB: %s", err.Error())
 	}
 
+	database := client.Database(cfg.DbConfig.MongoDB)
 	return &DB{
-		Client:          client,
-		GamesCollection: client.Database(cfg.DbConfig.MongoDB).Collection(cfg.DbConfig.Collection),
+		Client:            client,
+		GamesCollection:   database.Collection(cfg.DbConfig.Collection),
+		RatingsCollection: database.Collection(cfg.DbConfig.Ratings),
 	}, nil
 }
 
+// CreateIndexes makes sure the indexes the queries rely on exist
+func (db *DB) CreateIndexes(ctx context.Context) error {
+	_, err := db.RatingsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "player_id", Value: 1}, {Key: "duration", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create ratings index: %s", err.Error())
+	}
+
+	// the game lists of GameFilter, newest first, players also serves the opponent filter
+	_, err = db.GamesCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "players", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "white", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "black", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "players", Value: 1}, {Key: "duration", Value: 1}, {Key: "rated", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "players", Value: 1}, {Key: "result", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "players", Value: 1}, {Key: "started_at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create games indexes: %s", err.Error())
+	}
+	return nil
+}
+
+// CheckTransactions makes sure the deployment supports the transactions the ratings of rated games are
+// updated in, a standalone MongoDB server does not
+func (db *DB) CheckTransactions(ctx context.Context) error {
+	var hello bson.M
+	if err := db.Client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return fmt.Errorf("failed to describe the MongoDB deployment: %s", err.Error())
+	}
+	if _, replicaSet := hello["setName"]; replicaSet || hello["msg"] == "isdbgrid" {
+		return nil
+	}
+	return fmt.Errorf("MongoDB has to run as a replica set or a sharded cluster, ratings are updated in transactions")
+}
+
 // DisconnectDB to disconnect the db
 func (db *DB) DisconnectDB(ctx context.Context) error {
 	if err := db.Client.Disconnect(ctx); err != nil {
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"google.golang.org/protobuf/proto"
+)
+
+// PublishGameEvent fans the event out to every player of the game, whichever server instance they are connected to
+func (s *Storage) PublishGameEvent(gameID string, event *genprotos.GameEvent) {
+	event.GameId = gameID
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		s.logger.Println("Error encoding game event:", err)
+		return
+	}
+	if err := s.redisService.Publish(s.redisService.GameChannel(gameID), payload); err != nil {
+		s.logger.Println("Error publishing game event:", err)
+	}
+}
+
+// SubscribeGame delivers the events of the game until ctx is done
+func (s *Storage) SubscribeGame(ctx context.Context, gameID string) (<-chan *genprotos.GameEvent, error) {
+	messages, err := s.redisService.Subscribe(ctx, s.redisService.GameChannel(gameID))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *genprotos.GameEvent)
+	go func() {
+		defer close(events)
+		for payload := range messages {
+			var event genprotos.GameEvent
+			if err := proto.Unmarshal(payload, &event); err != nil {
+				s.logger.Println("Error decoding game event:", err)
+				continue
+			}
+			select {
+			case events <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
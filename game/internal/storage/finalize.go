@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// finishLive stops the clocks and marks the live game as finished with the given result
+func finishLive(live *models.LiveGame, outcome genprotos.GameOutcome, termination genprotos.Termination) {
+	clock.Stop(live, time.Now())
+	live.Status = models.StatusFinished
+	live.DrawOffer = chess.NoColor
+	live.Takeback = chess.NoColor
+	live.Outcome = outcome
+	live.Termination = termination
+}
+
+// abortLive calls the live game off without a result
+func abortLive(live *models.LiveGame) {
+	finishLive(live, genprotos.GameOutcome_ONGOING, genprotos.Termination_ABORTED)
+	live.Status = models.StatusAborted
+}
+
+// endGame ends a game that is not ended by a move (resignation, abort, flag-fall, ...),
+// end has to finish the live game with finishLive
+func (s *Storage) endGame(ctx context.Context, gameID string, end func(live *models.LiveGame) error) (*models.LiveGame, error) {
+	var ended *models.LiveGame
+	err := s.updateGame(gameID, func(live *models.LiveGame) error {
+		ended = live
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		return end(live)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.finalizeGame(ctx, gameID, ended)
+	return ended, nil
+}
+
+// finalizeGame is the single path every finished game goes through: the full record is archived
+// to MongoDB, the ratings of rated games are updated and the result is announced to the players
+func (s *Storage) finalizeGame(ctx context.Context, gameID string, live *models.LiveGame) {
+	if err := s.archiveGame(ctx, gameID, live); err != nil {
+		// the live state has no expiry yet, so nothing is lost and the clock sweeper archives it again
+		s.logger.Println("Failed to archive game in MongoDB:", err)
+		if err := s.redisService.AddPendingGame(gameID); err != nil {
+			s.logger.Printf("Failed to remember game %s for archiving: %s", gameID, err.Error())
+		}
+	} else if err := s.updateRatings(ctx, gameID, live); err != nil {
+		s.logger.Println("Failed to update ratings:", err)
+		if err := s.redisService.AddPendingGame(gameID); err != nil {
+			s.logger.Printf("Failed to remember game %s for rating: %s", gameID, err.Error())
+		}
+	}
+	if err := s.redisService.ForgetPresence(gameID, []string{live.White, live.Black}); err != nil {
+		s.logger.Println("Failed to stop tracking presence:", err)
+	}
+	s.publishResult(gameID, live.Outcome, live.Termination)
+}
+
+// retryPendingGames archives the finished games whose archiving failed and updates the ratings of those
+// whose rating update failed. It is safe to run on several replicas since archiving and rating a game
+// again change nothing
+func (s *Storage) retryPendingGames(ctx context.Context) {
+	gameIDs, err := s.redisService.PendingGames(sweepBatchSize)
+	if err != nil {
+		s.logger.Println("Failed to load pending games:", err)
+		return
+	}
+
+	for _, gameID := range gameIDs {
+		if err := s.finalizePending(ctx, gameID); err != nil {
+			s.logger.Printf("Failed to finalize pending game %s: %s", gameID, err.Error())
+			continue
+		}
+		if err := s.redisService.RemovePendingGame(gameID); err != nil {
+			s.logger.Printf("Failed to forget pending game %s: %s", gameID, err.Error())
+		}
+	}
+}
+
+// finalizePending archives the pending game if it is still live and updates its ratings
+func (s *Storage) finalizePending(ctx context.Context, gameID string) error {
+	live, err := s.redisService.GetGame(gameID)
+	switch {
+	case err == nil:
+		if err := s.archiveGame(ctx, gameID, live); err != nil {
+			return err
+		}
+	case errors.Is(err, redisservice.ErrGameNotFound):
+		// the live state only expires once the game is archived, so the ratings are updated from the archive
+		objID, err := primitive.ObjectIDFromHex(gameID)
+		if err != nil {
+			return err
+		}
+		var game models.GameModel
+		err = s.database.GamesCollection.FindOne(ctx, bson.M{"_id": objID}).Decode(&game)
+		if err == mongo.ErrNoDocuments || err == nil && game.Status == models.StatusOngoing {
+			s.logger.Printf("Pending game %s has nothing left to finalize", gameID)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if live, err = liveFromArchive(&game, time.Now()); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	return s.updateRatings(ctx, gameID, live)
+}
+
+func (s *Storage) publishResult(gameID string, outcome genprotos.GameOutcome, termination genprotos.Termination) {
+	s.PublishGameEvent(gameID, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_Result{Result: &genprotos.GameResult{
+			Outcome:     outcome,
+			Termination: termination,
+		}},
+	})
+}
+
+// archiveGame writes the complete record of the finished game to MongoDB,
+// the live state in Redis is only let go once MongoDB has the record
+func (s *Storage) archiveGame(ctx context.Context, gameID string, live *models.LiveGame) error {
+	objID, err := primitive.ObjectIDFromHex(gameID)
+	if err != nil {
+		return err
+	}
+
+	record := gameRecord(live, time.Now())
+	update := bson.M{
+		"$set": bson.M{
+			"players":        record.Players,
+			"white":          record.White,
+			"black":          record.Black,
+			"moves":          record.Moves,
+			"status":         record.Status,
+			"result":         record.Result,
+			"termination":    record.Termination,
+			"pgn":            record.PGN,
+			"start_fen":      record.StartFEN,
+			"final_fen":      record.FinalFEN,
+			"started_at":     record.StartedAt,
+			"ended_at":       record.EndedAt,
+			"white_clock_ms": record.WhiteClock,
+			"black_clock_ms": record.BlackClock,
+		},
+	}
+	if _, err := s.database.GamesCollection.UpdateOne(ctx, bson.M{"_id": objID}, update); err != nil {
+		return err
+	}
+
+	return s.redisService.ExpireGame(gameID, finishedGameTTL)
+}
+
+// gameRecord builds the archived form of the live game
+func gameRecord(live *models.LiveGame, endedAt time.Time) *models.GameModel {
+	record := &models.GameModel{
+		Players:     []string{live.White, live.Black},
+		White:       live.White,
+		Black:       live.Black,
+		Rated:       live.Rated,
+		Moves:       live.Records,
+		Status:      live.Status,
+		Result:      resultString(live.Outcome),
+		Termination: live.Termination.String(),
+		StartFEN:    live.StartFEN,
+		FinalFEN:    live.Game.FEN(),
+		StartedAt:   live.StartedAt,
+		EndedAt:     endedAt,
+		WhiteClock:  live.WhiteClock.Milliseconds(),
+		BlackClock:  live.BlackClock.Milliseconds(),
+	}
+	if record.Moves == nil {
+		record.Moves = []models.MoveRecord{}
+	}
+	record.PGN = RenderPGN(record)
+	return record
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// GetGame returns everything needed to show or resume the game. Games still kept live are read from Redis,
+// archived ones are rebuilt into the same live state from MongoDB, so both are rendered the same way
+func (s *Storage) GetGame(ctx context.Context, gameID string) (*genprotos.GetGameResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(gameID)
+	if err != nil {
+		return nil, ErrGameNotFound
+	}
+	var game models.GameModel
+	err = s.database.GamesCollection.FindOne(ctx, bson.M{"_id": objID}).Decode(&game)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	live, err := s.redisService.GetGame(gameID)
+	if errors.Is(err, redisservice.ErrGameNotFound) {
+		live, err = liveFromArchive(&game, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	white, err := s.gamePlayer(ctx, &game, live.White, genprotos.Color_WHITE, game.WhiteRating, game.WhiteRatingDiff)
+	if err != nil {
+		return nil, err
+	}
+	black, err := s.gamePlayer(ctx, &game, live.Black, genprotos.Color_BLACK, game.BlackRating, game.BlackRatingDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	played := playedRecords(live.Records)
+	moves := make([]*genprotos.GameMove, len(played))
+	for i, index := range played {
+		record := live.Records[index]
+		moves[i] = &genprotos.GameMove{
+			Uci:        record.UCI,
+			San:        record.SAN,
+			PlayedAtMs: record.PlayedAt.UnixMilli(),
+			ClockMs:    record.ClockMs,
+		}
+	}
+
+	resp := &genprotos.GetGameResponse{
+		GameId: gameID,
+		White:  white,
+		Black:  black,
+		TimeControl: &genprotos.GameTimeControl{
+			Duration:    int32(game.Duration),
+			BaseMs:      live.Base.Milliseconds(),
+			IncrementMs: live.Increment.Milliseconds(),
+			DelayMs:     live.Delay.Milliseconds(),
+		},
+		Rated:        live.Rated,
+		StartFen:     live.StartFEN,
+		Fen:          live.Game.FEN(),
+		Turn:         genprotos.Color_WHITE,
+		LegalMoves:   int32(len(live.Game.ValidMoves())),
+		WhiteClockMs: clock.Remaining(live, chess.White, now).Milliseconds(),
+		BlackClockMs: clock.Remaining(live, chess.Black, now).Milliseconds(),
+		Status:       live.Status,
+		Outcome:      live.Outcome,
+		Termination:  live.Termination,
+		Moves:        moves,
+		StartedAtMs:  live.StartedAt.UnixMilli(),
+		Imported:     game.Imported,
+	}
+	if live.Game.Position().Turn() == chess.Black {
+		resp.Turn = genprotos.Color_BLACK
+	}
+	if live.Status != models.StatusOngoing && !game.EndedAt.IsZero() {
+		resp.EndedAtMs = game.EndedAt.UnixMilli()
+	}
+	return resp, nil
+}
+
+// gamePlayer describes a player of the game, with the rating the game was rated with once it changed the ratings
+// and with the player's current rating until then. Imported games keep the ratings of their PGN
+func (s *Storage) gamePlayer(
+	ctx context.Context,
+	game *models.GameModel,
+	playerID string,
+	color genprotos.Color,
+	rating, diff int,
+) (*genprotos.GamePlayer, error) {
+	if !game.RatingsApplied && !game.Imported {
+		current, err := s.loadRating(ctx, playerID, game.Duration)
+		if err != nil {
+			return nil, err
+		}
+		rating, diff = roundRating(current.Rating), 0
+	}
+	return &genprotos.GamePlayer{
+		PlayerId:   playerID,
+		Color:      color,
+		Rating:     int32(rating),
+		RatingDiff: int32(diff),
+	}, nil
+}
+
+// liveFromArchive rebuilds the state of an archived game by replaying its moves
+func liveFromArchive(game *models.GameModel, now time.Time) (*models.LiveGame, error) {
+	startFEN := game.StartFEN
+	if startFEN == "" {
+		startFEN = startingFEN
+	}
+	fen, err := chess.FEN(startFEN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archived starting position: %s", err.Error())
+	}
+	position := chess.NewGame(fen, chess.UseNotation(chess.UCINotation{}))
+	for _, index := range playedRecords(game.Moves) {
+		if err := position.MoveStr(game.Moves[index].UCI); err != nil {
+			return nil, fmt.Errorf("invalid archived move %s: %s", game.Moves[index].UCI, err.Error())
+		}
+	}
+
+	return &models.LiveGame{
+		Game:       position,
+		StartFEN:   startFEN,
+		White:      game.White,
+		Black:      game.Black,
+		Base:       time.Duration(game.BaseMs) * time.Millisecond,
+		WhiteClock: time.Duration(game.WhiteClock) * time.Millisecond,
+		BlackClock: time.Duration(game.BlackClock) * time.Millisecond,
+		Increment:  time.Duration(game.IncrementMs) * time.Millisecond,
+		Delay:      time.Duration(game.DelayMs) * time.Millisecond,
+		// the clocks of an archived game do not run, even if it was never finished
+		TurnStarted: now,
+		Records:     game.Moves,
+		DrawOffer:   chess.NoColor,
+		WhiteOffer:  -1,
+		BlackOffer:  -1,
+		Takeback:    chess.NoColor,
+		Rated:       game.Rated,
+		Status:      game.Status,
+		Outcome:     outcomeFromResult(game.Result),
+		Termination: genprotos.Termination(genprotos.Termination_value[game.Termination]),
+		StartedAt:   game.StartedAt,
+	}, nil
+}
+
+// outcomeFromResult is the inverse of resultString
+func outcomeFromResult(result string) genprotos.GameOutcome {
+	switch result {
+	case chess.WhiteWon.String():
+		return genprotos.GameOutcome_WHITE_WON
+	case chess.BlackWon.String():
+		return genprotos.GameOutcome_BLACK_WON
+	case chess.Draw.String():
+		return genprotos.GameOutcome_DRAW
+	default:
+		return genprotos.GameOutcome_ONGOING
+	}
+}
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrInvalidCursor is returned when a page cursor was not returned by ListGames
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// GameFilter selects the games of a player, zero fields do not filter. Imported games are never selected,
+// their players are only the names of the PGN
+type GameFilter struct {
+	PlayerID   string
+	OpponentID string
+	Color      genprotos.Color // the colour PlayerID played with
+	Result     genprotos.PlayerResult
+	Duration   int8
+	Rated      *bool
+	Since      time.Time // started at or after
+	Until      time.Time // started before
+}
+
+// Query returns the MongoDB filter of the games, only games with an id below after are matched unless after is zero.
+// Every clause starts with players, white or black followed by _id so that one of the games indexes serves it
+func (f GameFilter) Query(after primitive.ObjectID) bson.D {
+	query := bson.D{}
+	switch f.Color {
+	case genprotos.Color_WHITE:
+		query = append(query, bson.E{Key: "white", Value: f.PlayerID})
+		if f.OpponentID != "" {
+			query = append(query, bson.E{Key: "black", Value: f.OpponentID})
+		}
+	case genprotos.Color_BLACK:
+		query = append(query, bson.E{Key: "black", Value: f.PlayerID})
+		if f.OpponentID != "" {
+			query = append(query, bson.E{Key: "white", Value: f.OpponentID})
+		}
+	default:
+		if f.OpponentID != "" {
+			query = append(query, bson.E{Key: "players", Value: bson.M{"$all": bson.A{f.PlayerID, f.OpponentID}}})
+		} else {
+			query = append(query, bson.E{Key: "players", Value: f.PlayerID})
+		}
+	}
+
+	if !after.IsZero() {
+		query = append(query, bson.E{Key: "_id", Value: bson.M{"$lt": after}})
+	}
+	query = append(query, bson.E{Key: "imported", Value: bson.M{"$ne": true}})
+	if f.Duration != 0 {
+		query = append(query, bson.E{Key: "duration", Value: f.Duration})
+	}
+	if f.Rated != nil {
+		query = append(query, bson.E{Key: "rated", Value: *f.Rated})
+	}
+
+	startedAt := bson.M{}
+	if !f.Since.IsZero() {
+		startedAt["$gte"] = f.Since
+	}
+	if !f.Until.IsZero() {
+		startedAt["$lt"] = f.Until
+	}
+	if len(startedAt) > 0 {
+		query = append(query, bson.E{Key: "started_at", Value: startedAt})
+	}
+
+	switch f.Result {
+	case genprotos.PlayerResult_WON:
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"white": f.PlayerID, "result": chess.WhiteWon.String()},
+			bson.M{"black": f.PlayerID, "result": chess.BlackWon.String()},
+		}})
+	case genprotos.PlayerResult_LOST:
+		query = append(query, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"white": f.PlayerID, "result": chess.BlackWon.String()},
+			bson.M{"black": f.PlayerID, "result": chess.WhiteWon.String()},
+		}})
+	case genprotos.PlayerResult_DRAWN:
+		query = append(query, bson.E{Key: "result", Value: chess.Draw.String()})
+	}
+	return query
+}
+
+// ListGames returns up to limit games matching the filter, the newest first, starting after the game of the cursor.
+// The returned cursor continues with the next page and is empty on the last one.
+// Paging on _id keeps pages stable while new games are created
+func (s *Storage) ListGames(ctx context.Context, filter GameFilter, cursor string, limit int) ([]*genprotos.GameSummary, string, error) {
+	var after primitive.ObjectID
+	if cursor != "" {
+		var err error
+		if after, err = primitive.ObjectIDFromHex(cursor); err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+	}
+
+	// one more than asked for tells whether there is a next page
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit) + 1).
+		SetProjection(bson.M{"pgn": 0})
+	found, err := s.database.GamesCollection.Find(ctx, filter.Query(after), opts)
+	if err != nil {
+		return nil, "", err
+	}
+	var games []models.GameModel
+	if err := found.All(ctx, &games); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(games) > limit {
+		games = games[:limit]
+		next = games[limit-1].ID.Hex()
+	}
+	summaries := make([]*genprotos.GameSummary, len(games))
+	for i := range games {
+		summaries[i] = gameSummary(&games[i])
+	}
+	return summaries, next, nil
+}
+
+func gameSummary(game *models.GameModel) *genprotos.GameSummary {
+	summary := &genprotos.GameSummary{
+		GameId:          game.ID.Hex(),
+		WhiteId:         game.White,
+		BlackId:         game.Black,
+		Duration:        int32(game.Duration),
+		TimeControl:     game.TimeControl,
+		Rated:           game.Rated,
+		Status:          game.Status,
+		Outcome:         outcomeFromResult(game.Result),
+		Termination:     genprotos.Termination(genprotos.Termination_value[game.Termination]),
+		Moves:           int32(len(playedRecords(game.Moves))),
+		StartedAtMs:     game.StartedAt.UnixMilli(),
+		WhiteRating:     int32(game.WhiteRating),
+		BlackRating:     int32(game.BlackRating),
+		WhiteRatingDiff: int32(game.WhiteRatingDiff),
+		BlackRatingDiff: int32(game.BlackRatingDiff),
+	}
+	if game.Status != models.StatusOngoing && !game.EndedAt.IsZero() {
+		summary.EndedAtMs = game.EndedAt.UnixMilli()
+	}
+	return summary
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// promotionSuffixes maps the pieces a pawn can be promoted to onto their UCI suffix
+var promotionSuffixes = map[genprotos.PieceType]string{
+	genprotos.PieceType_QUEEN:  "q",
+	genprotos.PieceType_ROOK:   "r",
+	genprotos.PieceType_BISHOP: "b",
+	genprotos.PieceType_KNIGHT: "n",
+}
+
+// decodeMove reads the move from its UCI, SAN or from/to form, in that order of preference.
+// The move is not validated against the position
+func decodeMove(pos *chess.Position, move *genprotos.Move) (*chess.Move, error) {
+	switch {
+	case move.Uci != "":
+		return chess.UCINotation{}.Decode(pos, strings.ToLower(move.Uci))
+	case move.San != "":
+		return chess.AlgebraicNotation{}.Decode(pos, move.San)
+	case move.MoveFrom != "" && move.MoveTo != "":
+		uci := strings.ToLower(move.MoveFrom + move.MoveTo)
+		if move.Promotion != nil {
+			suffix, ok := promotionSuffixes[move.GetPromotion()]
+			if !ok {
+				return nil, fmt.Errorf("a pawn cannot be promoted to a %s", strings.ToLower(move.GetPromotion().String()))
+			}
+			uci += suffix
+		}
+		return chess.UCINotation{}.Decode(pos, uci)
+	default:
+		return nil, errors.New("the move is empty")
+	}
+}
+
+// moveText describes the move the way the player has sent it
+func moveText(move *genprotos.Move) string {
+	switch {
+	case move.Uci != "":
+		return move.Uci
+	case move.San != "":
+		return move.San
+	case move.Promotion != nil:
+		return move.MoveFrom + move.MoveTo + "=" + move.GetPromotion().String()
+	default:
+		return move.MoveFrom + move.MoveTo
+	}
+}
+
+// encodeMove returns the canonical form of a move that was played from pos
+func encodeMove(pos *chess.Position, move *chess.Move, color chess.Color) *genprotos.Move {
+	encoded := &genprotos.Move{
+		MoveFrom: move.S1().String(),
+		MoveTo:   move.S2().String(),
+		IsWhite:  color == chess.White,
+		Uci:      chess.UCINotation{}.Encode(pos, move),
+		San:      chess.AlgebraicNotation{}.Encode(pos, move),
+	}
+	for piece, suffix := range promotionSuffixes {
+		if move.Promo().String() == suffix {
+			encoded.Promotion = piece.Enum()
+		}
+	}
+	return encoded
+}
+
+// recordToMove converts an archived move back into its proto form
+func recordToMove(record models.MoveRecord) *genprotos.Move {
+	move := &genprotos.Move{
+		Uci: record.UCI,
+		San: record.SAN,
+	}
+	if len(record.UCI) >= 4 {
+		move.MoveFrom = record.UCI[0:2]
+		move.MoveTo = record.UCI[2:4]
+	}
+	if len(record.UCI) == 5 {
+		for piece, suffix := range promotionSuffixes {
+			if record.UCI[4:] == suffix {
+				move.Promotion = piece.Enum()
+			}
+		}
+	}
+	return move
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+)
+
+// terminations maps the methods the chess library ends a game with onto their proto counterpart
+var terminations = map[chess.Method]genprotos.Termination{
+	chess.Checkmate:            genprotos.Termination_CHECKMATE,
+	chess.Resignation:          genprotos.Termination_RESIGNATION,
+	chess.DrawOffer:            genprotos.Termination_DRAW_AGREEMENT,
+	chess.Stalemate:            genprotos.Termination_STALEMATE,
+	chess.ThreefoldRepetition:  genprotos.Termination_THREEFOLD_REPETITION,
+	chess.FivefoldRepetition:   genprotos.Termination_FIVEFOLD_REPETITION,
+	chess.FiftyMoveRule:        genprotos.Termination_FIFTY_MOVE_RULE,
+	chess.SeventyFiveMoveRule:  genprotos.Termination_SEVENTY_FIVE_MOVE_RULE,
+	chess.InsufficientMaterial: genprotos.Termination_INSUFFICIENT_MATERIAL,
+}
+
+// IsCheck reports whether the last move of the game has put the opponent's king in check
+func IsCheck(game *chess.Game) bool {
+	moves := game.Moves()
+	return len(moves) > 0 && moves[len(moves)-1].HasTag(chess.Check)
+}
+
+// EvaluateOutcome returns how the game stands after its last move. The library only ends the game
+// on its own for mate, stalemate, fivefold repetition, the 75-move rule and insufficient material,
+// threefold repetition and the fifty-move rule are claimable draws and are applied here since
+// players have no way of claiming them
+func EvaluateOutcome(game *chess.Game) (genprotos.GameOutcome, genprotos.Termination) {
+	if game.Outcome() == chess.NoOutcome {
+		for _, method := range game.EligibleDraws() {
+			if method == chess.ThreefoldRepetition || method == chess.FiftyMoveRule {
+				game.Draw(method)
+				break
+			}
+		}
+	}
+
+	switch game.Outcome() {
+	case chess.WhiteWon:
+		return genprotos.GameOutcome_WHITE_WON, terminations[game.Method()]
+	case chess.BlackWon:
+		return genprotos.GameOutcome_BLACK_WON, terminations[game.Method()]
+	case chess.Draw:
+		return genprotos.GameOutcome_DRAW, terminations[game.Method()]
+	default:
+		return genprotos.GameOutcome_ONGOING, genprotos.Termination_NO_TERMINATION
+	}
+}
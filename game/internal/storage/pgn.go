@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// pgnLineLength is the length PGN movetext lines are wrapped at
+const pgnLineLength = 80
+
+// startingFEN is the FEN of the standard starting position
+var startingFEN = chess.NewGame().FEN()
+
+// resultString returns the PGN result of the outcome
+func resultString(outcome genprotos.GameOutcome) string {
+	switch outcome {
+	case genprotos.GameOutcome_WHITE_WON:
+		return chess.WhiteWon.String()
+	case genprotos.GameOutcome_BLACK_WON:
+		return chess.BlackWon.String()
+	case genprotos.GameOutcome_DRAW:
+		return chess.Draw.String()
+	default:
+		return chess.NoOutcome.String()
+	}
+}
+
+// tagEscaper escapes the value of a PGN tag, tagUnescaper undoes it
+var (
+	tagEscaper   = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	tagUnescaper = strings.NewReplacer(`\\`, `\`, `\"`, `"`)
+)
+
+// RenderPGN renders the game in PGN, with the clock of the mover after every move of timed games.
+// Ratings are tagged if the game has them, imported games keep every other tag of their PGN
+func RenderPGN(game *models.GameModel) string {
+	result := game.Result
+	if result == "" {
+		result = chess.NoOutcome.String()
+	}
+
+	var b strings.Builder
+	written := make(map[string]bool)
+	writeTag := func(key, value string) {
+		written[key] = true
+		fmt.Fprintf(&b, "[%s \"%s\"]\n", key, tagEscaper.Replace(value))
+	}
+	tagOr := func(key, value string) string {
+		if tag, ok := game.Tags[key]; ok {
+			return tag
+		}
+		return value
+	}
+	writeTag("Event", tagOr("Event", "Online game"))
+	writeTag("Site", tagOr("Site", "chess_app"))
+	writeTag("Date", tagOr("Date", game.StartedAt.UTC().Format("2006.01.02")))
+	writeTag("Round", tagOr("Round", "-"))
+	writeTag("White", game.White)
+	writeTag("Black", game.Black)
+	writeTag("Result", result)
+	if game.WhiteRating != 0 {
+		writeTag("WhiteElo", strconv.Itoa(game.WhiteRating))
+	}
+	if game.BlackRating != 0 {
+		writeTag("BlackElo", strconv.Itoa(game.BlackRating))
+	}
+	if game.RatingsApplied {
+		writeTag("WhiteRatingDiff", fmt.Sprintf("%+d", game.WhiteRatingDiff))
+		writeTag("BlackRatingDiff", fmt.Sprintf("%+d", game.BlackRatingDiff))
+	}
+	if game.TimeControl != "" {
+		writeTag("TimeControl", game.TimeControl)
+	}
+	if game.StartFEN != "" && game.StartFEN != startingFEN {
+		writeTag("SetUp", "1")
+		writeTag("FEN", game.StartFEN)
+	}
+	if game.Termination != "" {
+		writeTag("Termination", game.Termination)
+	}
+	// the remaining tags of an imported game, e.g. ECO or Opening
+	keys := make([]string, 0, len(game.Tags))
+	for key := range game.Tags {
+		if !written[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeTag(key, game.Tags[key])
+	}
+	b.WriteString("\n")
+
+	moveNumber, blackToMove := 1, false
+	if fields := strings.Fields(game.StartFEN); len(fields) == 6 {
+		blackToMove = fields[1] == "b"
+		if n, err := strconv.Atoi(fields[5]); err == nil {
+			moveNumber = n
+		}
+	}
+
+	timed := game.TimeControl != "" || game.BaseMs > 0
+	var tokens []string
+	for i, index := range playedRecords(game.Moves) {
+		switch {
+		case !blackToMove:
+			tokens = append(tokens, strconv.Itoa(moveNumber)+".")
+		case i == 0 || timed: // black moves are numbered again after the clock comment of white's move
+			tokens = append(tokens, strconv.Itoa(moveNumber)+"...")
+		}
+		tokens = append(tokens, game.Moves[index].SAN)
+		if timed {
+			tokens = append(tokens, "{ [%clk "+formatClock(game.Moves[index].ClockMs)+"] }")
+		}
+		if blackToMove {
+			moveNumber++
+		}
+		blackToMove = !blackToMove
+	}
+	tokens = append(tokens, result)
+
+	lineLength := 0
+	for i, token := range tokens {
+		if i > 0 {
+			if lineLength+1+len(token) > pgnLineLength {
+				b.WriteString("\n")
+				lineLength = 0
+			} else {
+				b.WriteString(" ")
+				lineLength++
+			}
+		}
+		b.WriteString(token)
+		lineLength += len(token)
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// formatClock formats a remaining time as h:mm:ss for %clk comments
+func formatClock(ms int64) string {
+	seconds := max(ms, 0) / 1000
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// parseClock parses the time of a %clk comment, it reports false if the comment has none
+func parseClock(comment string) (int64, bool) {
+	_, value, found := strings.Cut(comment, "[%clk ")
+	if !found {
+		return 0, false
+	}
+	value, _, found = strings.Cut(value, "]")
+	if !found {
+		return 0, false
+	}
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(hours)*3600_000 + int64(minutes)*60_000 + int64(seconds*1000), true
+}
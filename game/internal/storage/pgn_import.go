@@ -0,0 +1,284 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrInvalidPGN is returned when games can not be imported from a PGN, it is wrapped with the reason
+var ErrInvalidPGN = errors.New("invalid PGN")
+
+// maxImportedGames is the number of games one PGN can import at most
+const maxImportedGames = 100
+
+// ExportPGN renders the game in PGN, games still kept live are rendered as they stand now.
+// Games whose ratings are not final yet are tagged with the current ratings of the players
+func (s *Storage) ExportPGN(ctx context.Context, gameID string) (string, error) {
+	objID, err := primitive.ObjectIDFromHex(gameID)
+	if err != nil {
+		return "", ErrGameNotFound
+	}
+	var game models.GameModel
+	err = s.database.GamesCollection.FindOne(ctx, bson.M{"_id": objID}).Decode(&game)
+	if err == mongo.ErrNoDocuments {
+		return "", ErrGameNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	live, err := s.redisService.GetGame(gameID)
+	switch {
+	case err == nil:
+		record := gameRecord(live, time.Time{})
+		game.Moves = record.Moves
+		game.Status = record.Status
+		game.Result = record.Result
+		game.Termination = record.Termination
+		if live.Termination == genprotos.Termination_NO_TERMINATION {
+			game.Termination = ""
+		}
+		game.StartFEN = record.StartFEN
+	case !errors.Is(err, redisservice.ErrGameNotFound):
+		return "", err
+	}
+
+	if !game.RatingsApplied && !game.Imported {
+		white, err := s.loadRating(ctx, game.White, game.Duration)
+		if err != nil {
+			return "", err
+		}
+		black, err := s.loadRating(ctx, game.Black, game.Duration)
+		if err != nil {
+			return "", err
+		}
+		game.WhiteRating, game.BlackRating = roundRating(white.Rating), roundRating(black.Rating)
+	}
+	return RenderPGN(&game), nil
+}
+
+// ImportPGN stores every game of the PGN as an imported game and returns their ids in the same order.
+// Either all games are imported or, if any of them is invalid, none
+func (s *Storage) ImportPGN(ctx context.Context, playerID, pgn string) ([]string, error) {
+	games, err := ParsePGN(pgn, playerID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(games))
+	documents := make([]interface{}, len(games))
+	for i, game := range games {
+		game.ID = primitive.NewObjectID()
+		game.PGN = RenderPGN(game)
+		ids[i] = game.ID.Hex()
+		documents[i] = game
+	}
+	if _, err := s.database.GamesCollection.InsertMany(ctx, documents); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ParsePGN parses every game of the PGN into an imported game, each move is validated by replaying it.
+// Games without a known date are dated now
+func ParsePGN(pgn, importedBy string, now time.Time) ([]*models.GameModel, error) {
+	parsed, err := scanPGN(pgn)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return nil, fmt.Errorf("%w: no games found", ErrInvalidPGN)
+	}
+
+	games := make([]*models.GameModel, len(parsed))
+	for i, game := range parsed {
+		if games[i], err = importedGame(game, importedBy, now); err != nil {
+			return nil, fmt.Errorf("%w: game %d: %s", ErrInvalidPGN, i+1, err.Error())
+		}
+	}
+	return games, nil
+}
+
+func scanPGN(pgn string) (games []*chess.Game, err error) {
+	defer func() {
+		// the parser of the chess library panics on some malformed movetext, e.g. a comment before the first move
+		if r := recover(); r != nil {
+			games, err = nil, fmt.Errorf("%w: game %d: %v", ErrInvalidPGN, len(games)+1, r)
+		}
+	}()
+
+	scanner := chess.NewScanner(strings.NewReader(pgn))
+	for scanner.Scan() {
+		game := scanner.Next()
+		// what follows the last game
+		if len(game.TagPairs()) == 0 && len(game.Moves()) == 0 {
+			continue
+		}
+		if len(games) == maxImportedGames {
+			return nil, fmt.Errorf("%w: at most %d games can be imported at once", ErrInvalidPGN, maxImportedGames)
+		}
+		games = append(games, game)
+	}
+	if err := scanner.Err(); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%w: game %d: %s", ErrInvalidPGN, len(games)+1, err.Error())
+	}
+	return games, nil
+}
+
+// importedGame builds the record of a parsed game, tags that have no field of their own are kept as they are
+func importedGame(game *chess.Game, importedBy string, now time.Time) (*models.GameModel, error) {
+	tags := make(map[string]string)
+	// the chess library keeps the values as they are written
+	for _, pair := range game.TagPairs() {
+		tags[pair.Key] = tagUnescaper.Replace(pair.Value)
+	}
+	take := func(keys ...string) string {
+		for _, key := range keys {
+			if value, ok := tags[key]; ok {
+				delete(tags, key)
+				return value
+			}
+		}
+		return ""
+	}
+
+	record := &models.GameModel{
+		White:      take("White"),
+		Black:      take("Black"),
+		Status:     models.StatusFinished,
+		Imported:   true,
+		ImportedBy: importedBy,
+		ImportedAt: now,
+		StartedAt:  now,
+	}
+	if record.White == "" {
+		record.White = "?"
+	}
+	if record.Black == "" {
+		record.Black = "?"
+	}
+	record.Players = []string{record.White, record.Black}
+
+	// the result of the movetext wins over the tag
+	tagResult := take("Result")
+	record.Result = string(game.Outcome())
+	if record.Result == "" {
+		record.Result = tagResult
+	}
+	switch record.Result {
+	case chess.WhiteWon.String(), chess.BlackWon.String(), chess.Draw.String(), chess.NoOutcome.String():
+	case "":
+		record.Result = chess.NoOutcome.String()
+	default:
+		return nil, fmt.Errorf("invalid result %q", record.Result)
+	}
+
+	date, clock := take("UTCDate", "Date"), take("UTCTime", "Time")
+	if startedAt, err := time.Parse("2006.01.02 15:04:05", date+" "+clock); err == nil {
+		record.StartedAt = startedAt
+	} else if startedAt, err := time.Parse("2006.01.02", date); err == nil {
+		record.StartedAt = startedAt
+	} else if date != "" {
+		tags["Date"] = date
+	}
+
+	if rating, err := strconv.Atoi(tags["WhiteElo"]); err == nil {
+		record.WhiteRating = rating
+		delete(tags, "WhiteElo")
+	}
+	if rating, err := strconv.Atoi(tags["BlackElo"]); err == nil {
+		record.BlackRating = rating
+		delete(tags, "BlackElo")
+	}
+
+	if base, increment, ok := parseTimeControl(tags["TimeControl"]); ok {
+		record.TimeControl = take("TimeControl")
+		record.BaseMs, record.IncrementMs = base.Milliseconds(), increment.Milliseconds()
+		if base%time.Minute == 0 && base/time.Minute <= 127 {
+			record.Duration = int8(base / time.Minute)
+		}
+	}
+
+	positions, moves, comments := game.Positions(), game.Moves(), game.Comments()
+	record.Moves = make([]models.MoveRecord, len(moves))
+	for i, move := range moves {
+		record.Moves[i] = models.MoveRecord{
+			UCI: chess.UCINotation{}.Encode(positions[i], move),
+			SAN: chess.AlgebraicNotation{}.Encode(positions[i], move),
+		}
+		if i >= len(comments) {
+			continue
+		}
+		for _, comment := range comments[i] {
+			if ms, ok := parseClock(comment); ok {
+				record.Moves[i].ClockMs = ms
+				if positions[i].Turn() == chess.White {
+					record.WhiteClock = ms
+				} else {
+					record.BlackClock = ms
+				}
+			}
+		}
+	}
+	take("SetUp", "FEN")
+	record.StartFEN = positions[0].String()
+	record.FinalFEN = game.FEN()
+
+	// a game that ends on the board has to have the matching result
+	final := game.Position()
+	switch final.Status() {
+	case chess.Checkmate:
+		winner := chess.WhiteWon
+		if final.Turn() == chess.White {
+			winner = chess.BlackWon
+		}
+		if record.Result != winner.String() {
+			return nil, fmt.Errorf("the game ends in checkmate but the result is %s", record.Result)
+		}
+		record.Termination = genprotos.Termination_CHECKMATE.String()
+	case chess.Stalemate:
+		if record.Result != chess.Draw.String() {
+			return nil, fmt.Errorf("the game ends in stalemate but the result is %s", record.Result)
+		}
+		record.Termination = genprotos.Termination_STALEMATE.String()
+	default:
+		if termination, ok := genprotos.Termination_value[tags["Termination"]]; ok && termination != 0 {
+			record.Termination = take("Termination")
+		}
+	}
+
+	if len(tags) > 0 {
+		record.Tags = tags
+	}
+	return record, nil
+}
+
+// parseTimeControl parses a PGN TimeControl tag of a single base+increment period in seconds
+func parseTimeControl(tag string) (base, increment time.Duration, ok bool) {
+	baseStr, incrementStr, found := strings.Cut(tag, "+")
+	if !found {
+		incrementStr = "0"
+	}
+	baseSec, err := strconv.Atoi(baseStr)
+	if err != nil || baseSec <= 0 {
+		return 0, 0, false
+	}
+	incrementSec, err := strconv.Atoi(incrementStr)
+	if err != nil || incrementSec < 0 {
+		return 0, 0, false
+	}
+	return time.Duration(baseSec) * time.Second, time.Duration(incrementSec) * time.Second, true
+}
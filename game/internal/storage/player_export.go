@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrRateLimited is returned when a player started more exports than allowed within the export window
+var ErrRateLimited = errors.New("too many exports, please try again later")
+
+// exportBatchSize is how many games are read from MongoDB at a time, it bounds the memory an export takes
+// however many games the player has
+const exportBatchSize = 100
+
+// gameJSON is the JSON form of an exported game
+type gameJSON struct {
+	ID              string              `json:"id"`
+	White           string              `json:"white"`
+	Black           string              `json:"black"`
+	Duration        int8                `json:"duration"`
+	TimeControl     string              `json:"time_control,omitempty"`
+	Rated           bool                `json:"rated"`
+	Status          string              `json:"status"`
+	Result          string              `json:"result"`
+	Termination     string              `json:"termination,omitempty"`
+	StartFEN        string              `json:"start_fen,omitempty"`
+	Moves           []models.MoveRecord `json:"moves"` // taken back moves are left out
+	StartedAt       time.Time           `json:"started_at"`
+	EndedAt         time.Time           `json:"ended_at"`
+	WhiteRating     int                 `json:"white_rating,omitempty"`
+	BlackRating     int                 `json:"black_rating,omitempty"`
+	WhiteRatingDiff int                 `json:"white_rating_diff,omitempty"`
+	BlackRatingDiff int                 `json:"black_rating_diff,omitempty"`
+}
+
+// ExportPlayerGames sends every finished game matching the filter in the given format, one game at a time as it is
+// read from MongoDB. Every call counts against the player's exports, ErrRateLimited is returned once there were too many
+func (s *Storage) ExportPlayerGames(
+	ctx context.Context,
+	filter GameFilter,
+	oldestFirst bool,
+	format genprotos.ExportFormat,
+	send func(game *genprotos.ExportedGame) error,
+) error {
+	exports, err := s.redisService.CountExport(filter.PlayerID, s.gameConfig.ExportWindow)
+	if err != nil {
+		return err
+	}
+	if exports > s.gameConfig.ExportLimit {
+		return ErrRateLimited
+	}
+
+	order := -1
+	if oldestFirst {
+		order = 1
+	}
+	query := append(filter.Query(primitive.NilObjectID), bson.E{Key: "status", Value: bson.M{"$ne": models.StatusOngoing}})
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: order}}).
+		SetBatchSize(exportBatchSize).
+		SetProjection(bson.M{"pgn": 0})
+	cursor, err := s.database.GamesCollection.Find(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var game models.GameModel
+		if err := cursor.Decode(&game); err != nil {
+			return err
+		}
+		var data string
+		if format == genprotos.ExportFormat_NDJSON {
+			if data, err = RenderJSON(&game); err != nil {
+				return err
+			}
+		} else {
+			data = RenderPGN(&game) + "\n"
+		}
+		if err := send(&genprotos.ExportedGame{GameId: game.ID.Hex(), Data: data}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// RenderJSON renders the game as a line of NDJSON
+func RenderJSON(game *models.GameModel) (string, error) {
+	played := playedRecords(game.Moves)
+	moves := make([]models.MoveRecord, len(played))
+	for i, index := range played {
+		moves[i] = game.Moves[index]
+	}
+
+	data, err := json.Marshal(gameJSON{
+		ID:              game.ID.Hex(),
+		White:           game.White,
+		Black:           game.Black,
+		Duration:        game.Duration,
+		TimeControl:     game.TimeControl,
+		Rated:           game.Rated,
+		Status:          game.Status,
+		Result:          game.Result,
+		Termination:     game.Termination,
+		StartFEN:        game.StartFEN,
+		Moves:           moves,
+		StartedAt:       game.StartedAt,
+		EndedAt:         game.EndedAt,
+		WhiteRating:     game.WhiteRating,
+		BlackRating:     game.BlackRating,
+		WhiteRatingDiff: game.WhiteRatingDiff,
+		BlackRatingDiff: game.BlackRatingDiff,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
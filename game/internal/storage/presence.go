@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// errPlayerPresent is returned when a player checked for abandonment has come back in the meantime
+var errPlayerPresent = errors.New("player is present")
+
+// Heartbeat records that the player is connected to the game and reports whether the opponent is away
+func (s *Storage) Heartbeat(ctx context.Context, gameID, playerID string) (*genprotos.HeartbeatResponse, error) {
+	now := time.Now()
+	tracked, returned, err := s.redisService.TouchPresence(gameID, playerID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !tracked {
+		return nil, fmt.Errorf("%w: the player has no game in progress with this id", ErrGameNotFound)
+	}
+	if returned {
+		s.PublishGameEvent(gameID, &genprotos.GameEvent{
+			Event: &genprotos.GameEvent_PlayerReturned{PlayerReturned: &genprotos.PlayerReturned{PlayerId: playerID}},
+		})
+	}
+
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	opponent, err := opponentOf(players, playerID)
+	if err != nil {
+		return nil, err
+	}
+	away, err := s.isAway(gameID, opponent, now)
+	if err != nil {
+		return nil, err
+	}
+	spectators, err := s.SpectatorCount(gameID)
+	if err != nil {
+		return nil, err
+	}
+	return &genprotos.HeartbeatResponse{OpponentAway: away, Spectators: int32(spectators)}, nil
+}
+
+// touchPresence records that the player acted in the game, so that playing without a PlayGame stream
+// does not count as being away. Actions that end the game do not need it, the presence of a finished game is forgotten.
+// The action already took place, a failure is only logged
+func (s *Storage) touchPresence(gameID, playerID string) {
+	_, returned, err := s.redisService.TouchPresence(gameID, playerID, time.Now())
+	if err != nil {
+		s.logger.Println("Failed to record presence:", err)
+		return
+	}
+	if returned {
+		s.PublishGameEvent(gameID, &genprotos.GameEvent{
+			Event: &genprotos.GameEvent_PlayerReturned{PlayerReturned: &genprotos.PlayerReturned{PlayerId: playerID}},
+		})
+	}
+}
+
+// ClaimAbandonment ends the game of a player whose opponent has been away longer than the grace period,
+// either as a win or as a draw. Games the opponent has not moved in yet are aborted instead
+func (s *Storage) ClaimAbandonment(ctx context.Context, gameID, playerID string, draw bool) (*genprotos.GameActionResponse, error) {
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	opponent, err := opponentOf(players, playerID)
+	if err != nil {
+		return nil, err
+	}
+	away, err := s.isAway(gameID, opponent, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !away {
+		return nil, fmt.Errorf("%w: your opponent is still connected", ErrActionNotAllowed)
+	}
+
+	live, err := s.endGame(ctx, gameID, func(live *models.LiveGame) error {
+		color, err := playerColor([]string{live.White, live.Black}, opponent)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !hasMoved(live, color):
+			abortLive(live)
+		case draw:
+			finishLive(live, genprotos.GameOutcome_DRAW, genprotos.Termination_ABANDONMENT)
+			live.Game.Draw(chess.DrawOffer)
+		default:
+			forfeit(live, color, genprotos.Termination_ABANDONMENT)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return actionResponse(live), nil
+}
+
+// RunPresenceSweeper announces players who have been away longer than the grace period and ends
+// the games of those who stayed away past the forfeit time, every interval until ctx is done
+func (s *Storage) RunPresenceSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sweepPresence(ctx, now)
+		}
+	}
+}
+
+func (s *Storage) sweepPresence(ctx context.Context, now time.Time) {
+	grace, forfeitAfter := s.gameConfig.AbandonGrace, s.gameConfig.AbandonForfeit
+	absent, err := s.redisService.AbsentPlayers(now.Add(-grace), sweepBatchSize)
+	if err != nil {
+		s.logger.Println("Failed to load absent players:", err)
+		return
+	}
+
+	for _, pair := range absent {
+		gameID, playerID := pair[0], pair[1]
+		lastSeen, tracked, err := s.redisService.LastSeen(gameID, playerID)
+		if err != nil || !tracked {
+			continue
+		}
+
+		if now.Sub(lastSeen) < forfeitAfter {
+			first, err := s.redisService.MarkAway(gameID, playerID, forfeitAfter)
+			if err != nil {
+				s.logger.Println("Failed to mark player away:", err)
+				continue
+			}
+			if first {
+				s.PublishGameEvent(gameID, &genprotos.GameEvent{
+					Event: &genprotos.GameEvent_PlayerAway{PlayerAway: &genprotos.PlayerAway{
+						PlayerId:    playerID,
+						ForfeitInMs: lastSeen.Add(forfeitAfter).Sub(now).Milliseconds(),
+					}},
+				})
+			}
+			continue
+		}
+
+		err = s.forfeitAbandoned(ctx, gameID, playerID, now)
+		switch {
+		case err == nil, errors.Is(err, errPlayerPresent):
+		case errors.Is(err, ErrGameNotFound), errors.Is(err, ErrGameOver):
+			if err := s.redisService.ForgetPresence(gameID, []string{playerID}); err != nil {
+				s.logger.Println("Failed to stop tracking presence:", err)
+			}
+		default:
+			s.logger.Printf("Failed to forfeit game %s: %s", gameID, err.Error())
+		}
+	}
+}
+
+// forfeitAbandoned ends the game the player has left. If the opponent is away as well the game is drawn,
+// and a game the player has not moved in yet is aborted
+func (s *Storage) forfeitAbandoned(ctx context.Context, gameID, playerID string, now time.Time) error {
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return err
+	}
+	opponent, err := opponentOf(players, playerID)
+	if err != nil {
+		return err
+	}
+	opponentAway, err := s.isAway(gameID, opponent, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.endGame(ctx, gameID, func(live *models.LiveGame) error {
+		// the player may have come back since the sweep started
+		lastSeen, tracked, err := s.redisService.LastSeen(gameID, playerID)
+		if err != nil {
+			return err
+		}
+		if tracked && now.Sub(lastSeen) < s.gameConfig.AbandonForfeit {
+			return errPlayerPresent
+		}
+
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !hasMoved(live, color):
+			abortLive(live)
+		case opponentAway:
+			finishLive(live, genprotos.GameOutcome_DRAW, genprotos.Termination_ABANDONMENT)
+			live.Game.Draw(chess.DrawOffer)
+		default:
+			forfeit(live, color, genprotos.Termination_ABANDONMENT)
+		}
+		return nil
+	})
+	return err
+}
+
+// isAway reports whether the player of the game has not been seen for longer than the grace period
+func (s *Storage) isAway(gameID, playerID string, now time.Time) (bool, error) {
+	lastSeen, tracked, err := s.redisService.LastSeen(gameID, playerID)
+	if err != nil || !tracked {
+		return false, err
+	}
+	return now.Sub(lastSeen) > s.gameConfig.AbandonGrace, nil
+}
+
+// hasMoved reports whether the player of the given colour has made a move in the game
+func hasMoved(live *models.LiveGame, color chess.Color) bool {
+	positions := live.Game.Positions()
+	for i := range live.Game.Moves() {
+		if positions[i].Turn() == color {
+			return true
+		}
+	}
+	return false
+}
+
+// opponentOf returns the id of the opponent of the player in a game with the given players
+func opponentOf(players []string, playerID string) (string, error) {
+	color, err := playerColor(players, playerID)
+	if err != nil {
+		return "", err
+	}
+	if color == chess.White {
+		return players[1], nil
+	}
+	return players[0], nil
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"github.com/ruziba3vich/chess_app/pkg/glicko2"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GetRating returns the player's rating for games of the given duration,
+// players without rated games of that duration get the default rating
+func (s *Storage) GetRating(ctx context.Context, playerID string, duration int8) (*models.RatingModel, error) {
+	return s.loadRating(ctx, playerID, duration)
+}
+
+// GetRatings returns every rating the player has, one for each duration the player has played rated games of
+func (s *Storage) GetRatings(ctx context.Context, playerID string) ([]*models.RatingModel, error) {
+	cursor, err := s.database.RatingsCollection.Find(ctx, bson.M{"player_id": playerID},
+		options.Find().SetSort(bson.D{{Key: "duration", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var ratings []*models.RatingModel
+	if err := cursor.All(ctx, &ratings); err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+func (s *Storage) loadRating(ctx context.Context, playerID string, duration int8) (*models.RatingModel, error) {
+	var rating models.RatingModel
+	err := s.database.RatingsCollection.FindOne(ctx, bson.M{"player_id": playerID, "duration": duration}).Decode(&rating)
+	if err == mongo.ErrNoDocuments {
+		initial := glicko2.NewRating()
+		return &models.RatingModel{
+			PlayerID:   playerID,
+			Duration:   duration,
+			Rating:     initial.Rating,
+			Deviation:  initial.Deviation,
+			Volatility: initial.Volatility,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+// updateRatings applies the result of a finished rated game to the ratings of both players.
+// The ratings and the game are updated in one transaction, which needs MongoDB to run as a replica set,
+// and a game is only ever counted once
+func (s *Storage) updateRatings(ctx context.Context, gameID string, live *models.LiveGame) error {
+	whiteScore, decided := whiteScore(live.Outcome)
+	if !live.Rated || live.Status != models.StatusFinished || !decided {
+		return nil
+	}
+	objID, err := primitive.ObjectIDFromHex(gameID)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.database.Client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		var game models.GameModel
+		if err := s.database.GamesCollection.FindOne(sc, bson.M{"_id": objID}).Decode(&game); err != nil {
+			return nil, err
+		}
+		if game.RatingsApplied {
+			return nil, nil
+		}
+
+		white, err := s.loadRating(sc, live.White, game.Duration)
+		if err != nil {
+			return nil, err
+		}
+		black, err := s.loadRating(sc, live.Black, game.Duration)
+		if err != nil {
+			return nil, err
+		}
+
+		tau := s.gameConfig.RatingTau
+		newWhite := glicko2.Update(toGlicko(white), []glicko2.Result{{Opponent: toGlicko(black), Score: whiteScore}}, tau)
+		newBlack := glicko2.Update(toGlicko(black), []glicko2.Result{{Opponent: toGlicko(white), Score: 1 - whiteScore}}, tau)
+
+		now := time.Now()
+		if err := s.saveRating(sc, white, newWhite, now); err != nil {
+			return nil, err
+		}
+		if err := s.saveRating(sc, black, newBlack, now); err != nil {
+			return nil, err
+		}
+
+		_, err = s.database.GamesCollection.UpdateOne(sc, bson.M{"_id": objID}, bson.M{
+			"$set": bson.M{
+				"white_rating":      roundRating(white.Rating),
+				"black_rating":      roundRating(black.Rating),
+				"white_rating_diff": roundRating(newWhite.Rating) - roundRating(white.Rating),
+				"black_rating_diff": roundRating(newBlack.Rating) - roundRating(black.Rating),
+				"ratings_applied":   true,
+			},
+		})
+		return nil, err
+	})
+	return err
+}
+
+func (s *Storage) saveRating(ctx context.Context, old *models.RatingModel, updated glicko2.Rating, now time.Time) error {
+	_, err := s.database.RatingsCollection.UpdateOne(ctx,
+		bson.M{"player_id": old.PlayerID, "duration": old.Duration},
+		bson.M{
+			"$set": bson.M{
+				"rating":     updated.Rating,
+				"deviation":  updated.Deviation,
+				"volatility": updated.Volatility,
+				"updated_at": now,
+			},
+			"$inc": bson.M{"games": 1},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// whiteScore returns the score white got from the game, it reports false if the game has no result
+func whiteScore(outcome genprotos.GameOutcome) (float64, bool) {
+	switch outcome {
+	case genprotos.GameOutcome_WHITE_WON:
+		return 1, true
+	case genprotos.GameOutcome_BLACK_WON:
+		return 0, true
+	case genprotos.GameOutcome_DRAW:
+		return 0.5, true
+	}
+	return 0, false
+}
+
+func toGlicko(rating *models.RatingModel) glicko2.Rating {
+	return glicko2.Rating{Rating: rating.Rating, Deviation: rating.Deviation, Volatility: rating.Volatility}
+}
+
+func roundRating(rating float64) int {
+	return int(math.Round(rating))
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+)
+
+// SpectatorRefresh is how often a spectator has to be seen again to keep being counted
+const SpectatorRefresh = 5 * time.Second
+
+// spectatorTimeout is how long a spectator that stopped refreshing, e.g. because its server died, is still counted
+const spectatorTimeout = 3 * SpectatorRefresh
+
+// GameSnapshot returns the full state of a game that is still kept live, as shown to a spectator joining it
+func (s *Storage) GameSnapshot(ctx context.Context, gameID string) (*genprotos.GameSnapshot, error) {
+	live, err := s.redisService.GetGame(gameID)
+	if errors.Is(err, redisservice.ErrGameNotFound) {
+		return nil, ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	spectators, err := s.SpectatorCount(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	played := playedRecords(live.Records)
+	moves := make([]string, len(played))
+	for i, index := range played {
+		moves[i] = live.Records[index].SAN
+	}
+
+	now := time.Now()
+	snapshot := &genprotos.GameSnapshot{
+		Fen:          live.Game.FEN(),
+		Moves:        moves,
+		WhiteId:      live.White,
+		BlackId:      live.Black,
+		WhiteClockMs: clock.Remaining(live, chess.White, now).Milliseconds(),
+		BlackClockMs: clock.Remaining(live, chess.Black, now).Milliseconds(),
+		IncrementMs:  live.Increment.Milliseconds(),
+		DelayMs:      live.Delay.Milliseconds(),
+		Turn:         genprotos.Color_WHITE,
+		Status:       live.Status,
+		Outcome:      live.Outcome,
+		Termination:  live.Termination,
+		Rated:        live.Rated,
+		Spectators:   int32(spectators),
+		Version:      live.Version,
+	}
+	if live.Game.Position().Turn() == chess.Black {
+		snapshot.Turn = genprotos.Color_BLACK
+	}
+	switch live.DrawOffer {
+	case chess.White:
+		snapshot.DrawOfferedBy = live.White
+	case chess.Black:
+		snapshot.DrawOfferedBy = live.Black
+	}
+	return snapshot, nil
+}
+
+// AddSpectator counts the spectator as watching the game, it has to be called again every SpectatorRefresh.
+// The players and the other spectators are told about a new spectator
+func (s *Storage) AddSpectator(gameID, spectatorID string) error {
+	count, added, err := s.redisService.AddSpectator(gameID, spectatorID, time.Now(), spectatorTimeout)
+	if err != nil {
+		return err
+	}
+	if added {
+		s.publishSpectators(gameID, count)
+	}
+	return nil
+}
+
+// RemoveSpectator stops counting the spectator as watching the game
+func (s *Storage) RemoveSpectator(gameID, spectatorID string) error {
+	count, err := s.redisService.RemoveSpectator(gameID, spectatorID, time.Now(), spectatorTimeout)
+	if err != nil {
+		return err
+	}
+	s.publishSpectators(gameID, count)
+	return nil
+}
+
+// SpectatorCount returns the number of spectators watching the game
+func (s *Storage) SpectatorCount(gameID string) (int, error) {
+	return s.redisService.CountSpectators(gameID, time.Now(), spectatorTimeout)
+}
+
+func (s *Storage) publishSpectators(gameID string, count int) {
+	s.PublishGameEvent(gameID, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_Spectators{Spectators: &genprotos.SpectatorCount{Count: int32(count)}},
+	})
+}
@@ -2,21 +2,80 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/clock"
 	"github.com/ruziba3vich/chess_app/internal/genprotos"
 	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"github.com/ruziba3vich/chess_app/pkg/config"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func (s *Storage) CreateGameStorage(ctx context.Context, player1, player2 string, duration int8) (string, error) {
+// finishedGameTTL is how long the live state of a finished game is kept in Redis after it was archived
+const finishedGameTTL = 5 * time.Minute
+
+var (
+	// ErrGameNotFound is returned when neither Redis nor MongoDB knows the game
+	ErrGameNotFound = errors.New("game not found")
+	// ErrNotParticipant is returned when the player does not play in the game
+	ErrNotParticipant = errors.New("player does not play in this game")
+	// ErrNotYourTurn is returned when a player tries to move while it is the opponent's turn
+	ErrNotYourTurn = errors.New("it is not your turn")
+
+	// ErrGameOver is returned when an action needs a game that is still in progress
+	ErrGameOver = errors.New("the game is already over")
+	// ErrActionNotAllowed is returned when the state of the game does not allow the action, it is wrapped with the reason
+	ErrActionNotAllowed = errors.New("action not allowed")
+
+	errInvalidMove = errors.New("invalid move")
+)
+
+// GameSettings are the rules a game is created with
+type GameSettings struct {
+	Duration    int8 // minutes, identifies the time control
+	TimeControl config.TimeControl
+	Rated       bool
+	Takebacks   bool // whether the players may take moves back
+}
+
+// GameDuration returns the duration of the time control with the given minutes, it reports false if games
+// of that many minutes are not played
+func (s *Storage) GameDuration(minutes int32) (int8, bool) {
+	if minutes <= 0 || minutes > math.MaxInt8 {
+		return 0, false
+	}
+	duration := int8(minutes)
+	_, ok := s.gameConfig.TimeControls[duration]
+	return duration, ok
+}
+
+func (s *Storage) CreateGameStorage(ctx context.Context, player1, player2 string, settings GameSettings) (string, error) {
+	live := redisservice.NewLiveGame(player1, player2, settings.TimeControl)
+	live.Rated = settings.Rated
+	live.Takebacks = settings.Takebacks
+
 	// Create game model with both player IDs and duration
 	game := models.GameModel{
-		Players:  []string{player1, player2},
-		Moves:    []genprotos.Move{}, // Empty moves at the start
-		Duration: duration,           // Store duration
+		Players:     []string{player1, player2},
+		White:       player1,
+		Black:       player2,
+		Moves:       []models.MoveRecord{}, // Empty moves at the start
+		Duration:    settings.Duration,     // Store duration
+		TimeControl: settings.TimeControl.String(),
+		BaseMs:      settings.TimeControl.Base.Milliseconds(),
+		IncrementMs: settings.TimeControl.Increment.Milliseconds(),
+		DelayMs:     settings.TimeControl.Delay.Milliseconds(),
+		Rated:       settings.Rated,
+		Status:      models.StatusOngoing,
+		StartFEN:    live.StartFEN,
+		StartedAt:   live.StartedAt,
 	}
 
 	// Insert into MongoDB
@@ -27,93 +86,341 @@ func (s *Storage) CreateGameStorage(ctx context.Context, player1, player2 string
 	}
 
 	// Get inserted game ID
-	return result.InsertedID.(primitive.ObjectID).Hex(), nil
+	gameID := result.InsertedID.(primitive.ObjectID).Hex()
 
+	// Seed the live game, moves are played against it
+	if err := s.redisService.SaveGame(gameID, live); err != nil {
+		s.logger.Println("Error saving game to redis:", err)
+		return "", err
+	}
+	if err := s.redisService.TrackPresence(gameID, game.Players, live.StartedAt); err != nil {
+		s.logger.Println("Error tracking presence:", err)
+		return "", err
+	}
+
+	return gameID, nil
 }
 
 func (s *Storage) MakeMove(ctx context.Context, req *genprotos.MakeMoveRequest) (*genprotos.MakeMoveResponse, error) {
-	// Retrieve game from Redis
-	game, err := s.redisService.GetGame(req.GameId)
-	if err != nil {
-		return nil, fmt.Errorf("game not found: %s", err.Error())
+	if req.Move == nil {
+		return &genprotos.MakeMoveResponse{
+			Success: false,
+			Message: "the request does not contain a move",
+		}, nil
 	}
 
-	// Validate and apply move
-	moveStr := req.Move.MoveFrom + req.Move.MoveTo
-	if err := game.MoveStr(moveStr); err != nil {
+	var (
+		updated     *models.LiveGame
+		played      *genprotos.Move
+		outcome     genprotos.GameOutcome
+		termination genprotos.Termination
+		flagged     bool
+	)
+	// Validate and apply the move to the game stored in Redis
+	err := s.updateGame(req.GameId, func(live *models.LiveGame) error {
+		updated = live
+		game := live.Game
+		color, err := playerColor([]string{live.White, live.Black}, req.PlayerId)
+		if err != nil {
+			return err
+		}
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		if turn := game.Position().Turn(); color != turn {
+			return fmt.Errorf("%w, %s is to move", ErrNotYourTurn, turn.Name())
+		}
+
+		// a move that comes in after the flag fell loses on time, even if the sweeper has not noticed yet
+		now := time.Now()
+		if clock.Remaining(live, color, now) <= 0 {
+			forfeit(live, color, genprotos.Termination_TIMEOUT)
+			outcome, termination, flagged = live.Outcome, live.Termination, true
+			return nil
+		}
+
+		pos := game.Position()
+		move, err := decodeMove(pos, req.Move)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errInvalidMove, err.Error())
+		}
+		clock.Punch(live, color, now)
+		if err := game.Move(move); err != nil {
+			return errInvalidMove
+		}
+		// an open takeback request no longer fits the position
+		live.Takeback = chess.NoColor
+		live.TakebackPly = 0
+		// a draw offer lapses once the offering player moves after the opponent had the chance to answer
+		if live.DrawOffer == color && opponentMovedSince(live, color, lastDrawOffer(live, color)) {
+			live.DrawOffer = chess.NoColor
+		}
+		moves := game.Moves()
+		played = encodeMove(pos, moves[len(moves)-1], color)
+		live.Records = append(live.Records, models.MoveRecord{
+			UCI:      played.Uci,
+			SAN:      played.San,
+			PlayedAt: now,
+			ClockMs:  clock.Remaining(live, color, now).Milliseconds(),
+		})
+
+		outcome, termination = EvaluateOutcome(game)
+		if outcome != genprotos.GameOutcome_ONGOING {
+			finishLive(live, outcome, termination)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errInvalidMove):
 		return &genprotos.MakeMoveResponse{
 			Success: false,
-			Message: "Invalid move",
+			Message: fmt.Sprintf("%s is not a legal move in this position", moveText(req.Move)),
 			IsCheck: false,
 		}, nil
+	case errors.Is(err, ErrGameOver):
+		return &genprotos.MakeMoveResponse{
+			Success: false,
+			Message: "the game is already over",
+		}, nil
+	case err != nil:
+		return nil, err
 	}
 
-	// Check if the move results in a check
-	isCheck := detectCheck(game)
+	if flagged {
+		s.finalizeGame(ctx, req.GameId, updated)
+		return &genprotos.MakeMoveResponse{
+			Success:      false,
+			Message:      "you ran out of time",
+			Outcome:      outcome,
+			Termination:  termination,
+			WhiteClockMs: updated.WhiteClock.Milliseconds(),
+			BlackClockMs: updated.BlackClock.Milliseconds(),
+		}, nil
+	}
+
+	s.touchPresence(req.GameId, req.PlayerId)
 
 	// After successful move
 	resp := &genprotos.MakeMoveResponse{
-		Success:     true,
-		Message:     "successful move",
-		IsCheck:     isCheck,
-		IsCheckmate: detectCheckmate(game), // Fixed: was detectCheck
+		Success:      true,
+		Message:      "successful move",
+		IsCheck:      IsCheck(updated.Game),
+		IsCheckmate:  termination == genprotos.Termination_CHECKMATE,
+		Uci:          played.Uci,
+		San:          played.San,
+		Outcome:      outcome,
+		Termination:  termination,
+		WhiteClockMs: updated.WhiteClock.Milliseconds(),
+		BlackClockMs: updated.BlackClock.Milliseconds(),
 	}
 
-	// If game ends (checkmate), update MongoDB
-	if resp.IsCheckmate {
-		objID, _ := primitive.ObjectIDFromHex(req.GameId)
-		moves := game.Moves()
-		protoMoves := make([]genprotos.Move, len(moves))
-		for i, move := range moves {
-			protoMoves[i] = genprotos.Move{
-				MoveFrom: move.S1().String(),
-				MoveTo:   move.S2().String(),
-			}
+	s.PublishGameEvent(req.GameId, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_MoveMade{MoveMade: &genprotos.MoveMade{
+			PlayerId:     req.PlayerId,
+			Move:         played,
+			IsCheck:      resp.IsCheck,
+			IsCheckmate:  resp.IsCheckmate,
+			WhiteClockMs: resp.WhiteClockMs,
+			BlackClockMs: resp.BlackClockMs,
+			Version:      updated.Version,
+		}},
+	})
+
+	// If the move has ended the game, update MongoDB
+	if outcome != genprotos.GameOutcome_ONGOING {
+		s.finalizeGame(ctx, req.GameId, updated)
+	}
+
+	return resp, nil
+}
+
+// GetPlayers returns the ids of the game's players, the one at index 0 is white
+func (s *Storage) GetPlayers(ctx context.Context, gameID string) ([]string, error) {
+	if live, err := s.redisService.GetGame(gameID); err == nil {
+		return []string{live.White, live.Black}, nil
+	}
+
+	objID, err := primitive.ObjectIDFromHex(gameID)
+	if err != nil {
+		return nil, ErrGameNotFound
+	}
+
+	var gameModel models.GameModel
+	err = s.database.GamesCollection.FindOne(ctx, bson.M{"_id": objID}).Decode(&gameModel)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gameModel.Players, nil
+}
+
+// Resign ends the game in favour of the opponent of the given player
+func (s *Storage) Resign(ctx context.Context, gameID, playerID string) (*genprotos.GameActionResponse, error) {
+	live, err := s.endGame(ctx, gameID, func(live *models.LiveGame) error {
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
+		if err != nil {
+			return err
 		}
+		outcome := genprotos.GameOutcome_WHITE_WON
+		if color == chess.White {
+			outcome = genprotos.GameOutcome_BLACK_WON
+		}
+		live.Game.Resign(color)
+		finishLive(live, outcome, genprotos.Termination_RESIGNATION)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return actionResponse(live), nil
+}
 
-		update := bson.M{
-			"$set": bson.M{
-				"moves": protoMoves,
-			},
+// AbortGame calls the game off without a result, it is only allowed before both players have moved
+func (s *Storage) AbortGame(ctx context.Context, gameID, playerID string) (*genprotos.GameActionResponse, error) {
+	live, err := s.endGame(ctx, gameID, func(live *models.LiveGame) error {
+		if _, err := playerColor([]string{live.White, live.Black}, playerID); err != nil {
+			return err
+		}
+		if len(live.Game.Moves()) >= 2 {
+			return fmt.Errorf("%w: the game can only be aborted before both players have moved", ErrActionNotAllowed)
 		}
-		_, err := s.database.GamesCollection.UpdateOne(ctx, bson.M{"_id": objID}, update)
+		abortLive(live)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return actionResponse(live), nil
+}
+
+// OfferDraw records the player's draw offer and lets the opponent know about it.
+// A player has to wait for the opponent to move before offering again
+func (s *Storage) OfferDraw(ctx context.Context, gameID, playerID string) (*genprotos.GameActionResponse, error) {
+	err := s.updateGame(gameID, func(live *models.LiveGame) error {
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
 		if err != nil {
-			s.logger.Println("Failed to update game moves in MongoDB:", err)
+			return err
 		}
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		switch live.DrawOffer {
+		case color:
+			return fmt.Errorf("%w: your draw offer is still open", ErrActionNotAllowed)
+		case color.Other():
+			return fmt.Errorf("%w: your opponent has offered a draw, respond to it instead", ErrActionNotAllowed)
+		}
+		if last := lastDrawOffer(live, color); last >= 0 && !opponentMovedSince(live, color, last) {
+			return fmt.Errorf("%w: you can not offer a draw twice in a row", ErrActionNotAllowed)
+		}
+
+		live.DrawOffer = color
+		if color == chess.White {
+			live.WhiteOffer = len(live.Game.Moves())
+		} else {
+			live.BlackOffer = len(live.Game.Moves())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	s.touchPresence(gameID, playerID)
 
-	return resp, nil
+	s.PublishGameEvent(gameID, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_DrawOffered{DrawOffered: &genprotos.DrawOffered{PlayerId: playerID}},
+	})
+	return &genprotos.GameActionResponse{}, nil
 }
 
-// detectCheck checks if the current position is in check
-func detectCheck(game *chess.Game) bool {
-	// Get the board and current position
-	position := game.Position()
-	board := position.Board()
-	turn := position.Turn() // The player who just moved
+// RespondDraw accepts or declines the draw offered by the opponent of the given player
+func (s *Storage) RespondDraw(ctx context.Context, gameID, playerID string, accept bool) (*genprotos.GameActionResponse, error) {
+	var responded *models.LiveGame
+	err := s.updateGame(gameID, func(live *models.LiveGame) error {
+		responded = live
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
+		if err != nil {
+			return err
+		}
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		if live.DrawOffer != color.Other() {
+			return fmt.Errorf("%w: there is no draw offer to respond to", ErrActionNotAllowed)
+		}
 
-	// Find the opponent's king position
-	var kingSquare chess.Square
-	for sq, piece := range board.SquareMap() {
-		if piece.Type() == chess.King && piece.Color() != turn {
-			kingSquare = sq
-			break
+		live.DrawOffer = chess.NoColor
+		if !accept {
+			return nil
 		}
+		finishLive(live, genprotos.GameOutcome_DRAW, genprotos.Termination_DRAW_AGREEMENT)
+		return live.Game.Draw(chess.DrawOffer)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Get all legal moves in the current position
-	moves := game.ValidMoves()
+	if accept {
+		s.finalizeGame(ctx, gameID, responded)
+	} else {
+		s.touchPresence(gameID, playerID)
+		s.PublishGameEvent(gameID, &genprotos.GameEvent{
+			Event: &genprotos.GameEvent_DrawDeclined{DrawDeclined: &genprotos.DrawDeclined{PlayerId: playerID}},
+		})
+	}
+	return actionResponse(responded), nil
+}
+
+// lastDrawOffer returns how many moves were played when the player of the given colour last offered a draw
+func lastDrawOffer(live *models.LiveGame, color chess.Color) int {
+	if color == chess.White {
+		return live.WhiteOffer
+	}
+	return live.BlackOffer
+}
 
-	// Check if any move attacks the king's square
-	for _, move := range moves {
-		if move.S2() == kingSquare {
+// opponentMovedSince reports whether the opponent of the given colour has moved since ply
+func opponentMovedSince(live *models.LiveGame, color chess.Color, ply int) bool {
+	positions := live.Game.Positions()
+	for i := max(ply, 0); i < len(live.Game.Moves()); i++ {
+		if positions[i].Turn() != color {
 			return true
 		}
 	}
-
 	return false
 }
 
+func actionResponse(live *models.LiveGame) *genprotos.GameActionResponse {
+	return &genprotos.GameActionResponse{
+		Outcome:     live.Outcome,
+		Termination: live.Termination,
+	}
+}
+
+// updateGame runs redisService.UpdateGame and translates its errors
+func (s *Storage) updateGame(gameID string, update func(live *models.LiveGame) error) error {
+	err := s.redisService.UpdateGame(gameID, update)
+	if errors.Is(err, redisservice.ErrGameNotFound) {
+		return ErrGameNotFound
+	}
+	return err
+}
+
+// playerColor returns the colour the player has in a game with the given players
+func playerColor(players []string, playerID string) (chess.Color, error) {
+	if len(players) == 2 {
+		switch playerID {
+		case players[0]:
+			return chess.White, nil
+		case players[1]:
+			return chess.Black, nil
+		}
+	}
+	return chess.NoColor, ErrNotParticipant
+}
+
 func (s *Storage) GetGameStats(ctx context.Context, gameID string) (*genprotos.GetGameStatsResponse, error) {
 	// Initialize response
 	response := &genprotos.GetGameStatsResponse{
@@ -134,27 +441,20 @@ func (s *Storage) GetGameStats(ctx context.Context, gameID string) (*genprotos.G
 		if err != nil {
 			return nil, fmt.Errorf("game not found: %s", err.Error())
 		}
-		for i := range gameModel.Moves {
-			response.Moves = append(response.Moves, &gameModel.Moves[i])
+		for _, index := range playedRecords(gameModel.Moves) {
+			response.Moves = append(response.Moves, recordToMove(gameModel.Moves[index]))
 		}
 		return response, nil
 	}
 
 	// If game is found in Redis, get moves from the chess game
-	moves := game.Moves()
+	moves := game.Game.Moves()
+	positions := game.Game.Positions()
 	response.Moves = make([]*genprotos.Move, len(moves))
 
 	for i, move := range moves {
-		response.Moves[i] = &genprotos.Move{
-			MoveFrom: move.S1().String(),
-			MoveTo:   move.S2().String(),
-		}
+		response.Moves[i] = encodeMove(positions[i], move, positions[i].Turn())
 	}
 
 	return response, nil
 }
-
-func detectCheckmate(game *chess.Game) bool {
-	// If the current player has no valid moves and is in check → Checkmate!
-	return len(game.ValidMoves()) == 0 && detectCheck(game)
-}
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+)
+
+// RequestTakeback asks the opponent to undo the player's last move, together with the reply to it
+// if the opponent has already answered. With fullMove the last two moves are undone whoever played them
+func (s *Storage) RequestTakeback(ctx context.Context, gameID, playerID string, fullMove bool) (*genprotos.GameActionResponse, error) {
+	var plies int
+	err := s.updateGame(gameID, func(live *models.LiveGame) error {
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
+		if err != nil {
+			return err
+		}
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		if !live.Takebacks {
+			return fmt.Errorf("%w: takebacks are disabled in this game", ErrActionNotAllowed)
+		}
+		if live.Takeback != chess.NoColor {
+			return fmt.Errorf("%w: a takeback request is already open", ErrActionNotAllowed)
+		}
+		plies = takebackPlies(live, color, fullMove)
+		if plies == 0 {
+			return fmt.Errorf("%w: there is no move of yours to take back", ErrActionNotAllowed)
+		}
+
+		live.Takeback = color
+		live.TakebackPly = plies
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.touchPresence(gameID, playerID)
+
+	s.PublishGameEvent(gameID, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_TakebackRequested{TakebackRequested: &genprotos.TakebackRequested{
+			PlayerId: playerID,
+			Plies:    int32(plies),
+		}},
+	})
+	return &genprotos.GameActionResponse{}, nil
+}
+
+// RespondTakeback accepts or declines the takeback requested by the opponent of the given player,
+// on acceptance the moves are undone and the clocks are set back to what they were before them
+func (s *Storage) RespondTakeback(ctx context.Context, gameID, playerID string, accept bool) (*genprotos.GameActionResponse, error) {
+	var (
+		responded *models.LiveGame
+		plies     int
+	)
+	err := s.updateGame(gameID, func(live *models.LiveGame) error {
+		responded = live
+		color, err := playerColor([]string{live.White, live.Black}, playerID)
+		if err != nil {
+			return err
+		}
+		if live.Status != models.StatusOngoing {
+			return ErrGameOver
+		}
+		if live.Takeback != color.Other() {
+			return fmt.Errorf("%w: there is no takeback request to respond to", ErrActionNotAllowed)
+		}
+
+		plies = live.TakebackPly
+		live.Takeback = chess.NoColor
+		live.TakebackPly = 0
+		if !accept {
+			return nil
+		}
+		return rewind(live, plies, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.touchPresence(gameID, playerID)
+
+	if !accept {
+		s.PublishGameEvent(gameID, &genprotos.GameEvent{
+			Event: &genprotos.GameEvent_TakebackDeclined{TakebackDeclined: &genprotos.TakebackDeclined{PlayerId: playerID}},
+		})
+		return actionResponse(responded), nil
+	}
+
+	s.PublishGameEvent(gameID, &genprotos.GameEvent{
+		Event: &genprotos.GameEvent_TakenBack{TakenBack: &genprotos.TakenBack{
+			Plies:        int32(plies),
+			Fen:          responded.Game.FEN(),
+			WhiteClockMs: responded.WhiteClock.Milliseconds(),
+			BlackClockMs: responded.BlackClock.Milliseconds(),
+			Version:      responded.Version,
+		}},
+	})
+	return actionResponse(responded), nil
+}
+
+// takebackPlies returns how many moves a takeback requested by the player of the given colour undoes,
+// 0 if the player has no move to take back
+func takebackPlies(live *models.LiveGame, color chess.Color, fullMove bool) int {
+	n := len(live.Game.Moves())
+	if fullMove {
+		if n < 2 {
+			return 0
+		}
+		return 2
+	}
+
+	positions := live.Game.Positions()
+	for i := n - 1; i >= 0 && i >= n-2; i-- {
+		if positions[i].Turn() == color {
+			return n - i
+		}
+	}
+	return 0
+}
+
+// rewind undoes the last plies moves of the live game. The undone moves stay in the records marked as
+// taken back, and every player gets back the time they had before their undone move
+func rewind(live *models.LiveGame, plies int, now time.Time) error {
+	moves := live.Game.Moves()
+	positions := live.Game.Positions()
+	kept := len(moves) - plies
+	if plies <= 0 || kept < 0 {
+		return fmt.Errorf("%w: there are not enough moves to take back", ErrActionNotAllowed)
+	}
+
+	fen, err := chess.FEN(live.StartFEN)
+	if err != nil {
+		return err
+	}
+	game := chess.NewGame(fen, chess.UseNotation(chess.UCINotation{}))
+	for i, move := range moves[:kept] {
+		if err := game.MoveStr(chess.UCINotation{}.Encode(positions[i], move)); err != nil {
+			return err
+		}
+	}
+	live.Game = game
+
+	// records of moves still in the game line up with the game's moves
+	played := playedRecords(live.Records)
+	for _, index := range played[min(kept, len(played)):] {
+		live.Records[index].TakenBack = true
+	}
+	live.WhiteClock, live.BlackClock = live.Base, live.Base
+	for ply, index := range played[:min(kept, len(played))] {
+		clockAfter := time.Duration(live.Records[index].ClockMs) * time.Millisecond
+		if positions[ply].Turn() == chess.White {
+			live.WhiteClock = clockAfter
+		} else {
+			live.BlackClock = clockAfter
+		}
+	}
+
+	live.TurnStarted = now
+	live.DrawOffer = chess.NoColor
+	// offers made in the undone moves are undone with them, so the players may offer again
+	if live.WhiteOffer > kept {
+		live.WhiteOffer = -1
+	}
+	if live.BlackOffer > kept {
+		live.BlackOffer = -1
+	}
+	return nil
+}
+
+// playedRecords returns the indexes of the records of moves that were not taken back
+func playedRecords(records []models.MoveRecord) []int {
+	played := make([]int, 0, len(records))
+	for i, record := range records {
+		if !record.TakenBack {
+			played = append(played, i)
+		}
+	}
+	return played
+}
@@ -1,9 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -11,9 +14,10 @@ import (
 // DbConfig holds the database configuration
 type (
 	DbConfig struct {
-		MongoURI   string
+		MongoURI   string // has to point to a replica set or a sharded cluster, ratings are updated in transactions
 		MongoDB    string
 		Collection string
+		Ratings    string // collection the players' ratings are kept in
 	}
 
 	// Config holds the application configuration
@@ -25,15 +29,38 @@ type (
 		RedisURI     string
 		KafkaBrokers string // Kafka brokers (comma-separated)
 		KafkaTopic   string // Kafka topic for move events
+		LogFile      string // file the application logger writes to
 	}
 
 	// GameConfig keeps the game configuration elements
 	GameConfig struct {
 		ScoreQueue     string // queue name in redis for users to be grouped in
-		RankRange      int8   // players will be choosen in this range of score
+		RankRange      int32  // half width of the rating window a player starts searching with
+		RangeGrowth    int32  // rating points the window widens by for every second of waiting
+		MaxRankRange   int32  // half width the window stops widening at
 		SearchDuration int8   // game is gonna be in search for opponent for this many minutes
 		RedisChannel   string
 		WorkerPoolSize int8
+		Durations      []int8               // game durations in minutes, a matchmaking pool is started for each one
+		TimeControls   map[int8]TimeControl // clock settings of the games of each duration
+		LuaScriptPath  string               // path to the matchmaking lua script
+		ClaimTimeout   time.Duration        // how long a match waits for the replicas of both players to claim it
+		ChallengeTTL   time.Duration        // how long a challenge can be accepted
+		SweepInterval  time.Duration        // how often games are checked for players who ran out of time or left
+		AbandonGrace   time.Duration        // a player not seen for this long is away, the opponent may claim the game
+		AbandonForfeit time.Duration        // a player not seen for this long forfeits the game
+		RatedTakebacks bool                 // whether players of rated games may take moves back, casual games always may
+		RatingTau      float64              // Glicko-2 system constant, constrains how fast volatility changes
+		ExportLimit    int                  // exports of all their games a player may start within ExportWindow
+		ExportWindow   time.Duration
+	}
+
+	// TimeControl is the clock setting of a game, every player starts with Base on the clock
+	// and gets Increment added after each move. Delay is spent from each move before the clock starts running
+	TimeControl struct {
+		Base      time.Duration
+		Increment time.Duration
+		Delay     time.Duration
 	}
 )
 
@@ -47,24 +74,92 @@ func LoadConfig() (*Config, error) {
 	searchDurationInt, _ := strconv.Atoi(searchDurationStr)
 	workerPoolSizeStr, _ := strconv.Atoi(getEnv("WORKER_POOL_SIZE", "5"))
 	workerPoolSizeInt8 := int8(workerPoolSizeStr)
+	durations, timeControls, err := parseTimeControls(getEnv("TIME_CONTROLS", "1+0,3+2,5+0,10+0"))
+	if err != nil {
+		return nil, err
+	}
+	rankRange, err := strconv.Atoi(getEnv("RANK_RANGE", "100"))
+	if err != nil || rankRange < 0 {
+		return nil, fmt.Errorf("invalid RANK_RANGE %q", getEnv("RANK_RANGE", "100"))
+	}
+	rangeGrowth, err := strconv.Atoi(getEnv("RANK_RANGE_GROWTH", "10"))
+	if err != nil || rangeGrowth < 0 {
+		return nil, fmt.Errorf("invalid RANK_RANGE_GROWTH %q", getEnv("RANK_RANGE_GROWTH", "10"))
+	}
+	maxRankRange, err := strconv.Atoi(getEnv("MAX_RANK_RANGE", "500"))
+	if err != nil || maxRankRange < rankRange {
+		return nil, fmt.Errorf("invalid MAX_RANK_RANGE %q, it can not be below RANK_RANGE", getEnv("MAX_RANK_RANGE", "500"))
+	}
+	ratingTau, err := strconv.ParseFloat(getEnv("GLICKO_TAU", "0.5"), 64)
+	if err != nil || ratingTau <= 0 {
+		return nil, fmt.Errorf("invalid GLICKO_TAU %q", getEnv("GLICKO_TAU", "0.5"))
+	}
+	sweepInterval, err := parsePositiveDuration("SWEEP_INTERVAL", "1s")
+	if err != nil {
+		return nil, err
+	}
+	claimTimeout, err := parsePositiveDuration("MATCH_CLAIM_TIMEOUT", "3s")
+	if err != nil {
+		return nil, err
+	}
+	challengeTTL, err := parsePositiveDuration("CHALLENGE_TTL", "10m")
+	if err != nil {
+		return nil, err
+	}
+	abandonGrace, err := parsePositiveDuration("ABANDON_GRACE", "30s")
+	if err != nil {
+		return nil, err
+	}
+	abandonForfeit, err := parsePositiveDuration("ABANDON_FORFEIT", "2m")
+	if err != nil {
+		return nil, err
+	}
+	exportLimit, err := strconv.Atoi(getEnv("EXPORT_LIMIT", "3"))
+	if err != nil || exportLimit <= 0 {
+		return nil, fmt.Errorf("invalid EXPORT_LIMIT %q", getEnv("EXPORT_LIMIT", "3"))
+	}
+	exportWindow, err := parsePositiveDuration("EXPORT_WINDOW", "1h")
+	if err != nil {
+		return nil, err
+	}
+	if abandonForfeit < abandonGrace {
+		return nil, fmt.Errorf("ABANDON_FORFEIT can not be shorter than ABANDON_GRACE")
+	}
 
 	return &Config{
 		DbConfig: &DbConfig{
 			MongoURI:   getEnv("MONGO_URI", "mongodb://localhost:27017"),
 			MongoDB:    getEnv("MONGO_DB", "test"),
 			Collection: getEnv("MONGO_COLLECTION", "users"),
+			Ratings:    getEnv("MONGO_RATINGS_COLLECTION", "ratings"),
 		},
 		GameConfig: &GameConfig{
 			ScoreQueue:     getEnv("MATCH_MAKING_QUEUE_NAME", "match_making_queue_name"),
 			SearchDuration: int8(searchDurationInt),
+			RankRange:      int32(rankRange),
+			RangeGrowth:    int32(rangeGrowth),
+			MaxRankRange:   int32(maxRankRange),
 			RedisChannel:   getEnv("REDIS_CHANNEL", "redis_channel"),
 			WorkerPoolSize: workerPoolSizeInt8,
+			Durations:      durations,
+			TimeControls:   timeControls,
+			LuaScriptPath:  getEnv("LUA_SCRIPT_PATH", "pkg/scripts/lua_script.txt"),
+			ClaimTimeout:   claimTimeout,
+			ChallengeTTL:   challengeTTL,
+			SweepInterval:  sweepInterval,
+			AbandonGrace:   abandonGrace,
+			AbandonForfeit: abandonForfeit,
+			RatedTakebacks: getEnv("RATED_TAKEBACKS", "false") == "true",
+			RatingTau:      ratingTau,
+			ExportLimit:    exportLimit,
+			ExportWindow:   exportWindow,
 		},
 		Port:         getEnv("PORT", "8080"),
 		Protocol:     getEnv("PROTOCOL", "tcp"),
 		RedisURI:     getEnv("REDIS_URI", "redis:6379"),
 		KafkaBrokers: getEnv("KAFKA_BROKERS", "localhost:9092"),
 		KafkaTopic:   getEnv("KAFKA_TOPIC", "chess-moves"),
+		LogFile:      getEnv("LOG_FILE", "app.log"),
 	}, nil
 }
 
@@ -76,6 +171,68 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// parsePositiveDuration reads a duration such as "30s" from the environment
+func parsePositiveDuration(key, fallback string) (time.Duration, error) {
+	value := getEnv(key, fallback)
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid %s %q", key, value)
+	}
+	return duration, nil
+}
+
+// parseTimeControls parses a comma-separated list of time controls written as
+// <minutes>+<increment seconds>, optionally followed by d<delay seconds> (e.g. "3+2", "5+0d3").
+// The minutes identify the time control, so there can be only one for each duration
+func parseTimeControls(value string) ([]int8, map[int8]TimeControl, error) {
+	var durations []int8
+	timeControls := make(map[int8]TimeControl)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		invalid := fmt.Errorf("invalid time control %q", part)
+
+		rest, delay, hasDelay := strings.Cut(part, "d")
+		minutesStr, incrementStr, hasIncrement := strings.Cut(rest, "+")
+		if !hasIncrement {
+			incrementStr = "0"
+		}
+		if !hasDelay {
+			delay = "0"
+		}
+		minutes, err := strconv.ParseInt(minutesStr, 10, 8)
+		if err != nil || minutes <= 0 {
+			return nil, nil, invalid
+		}
+		increment, err := strconv.Atoi(incrementStr)
+		if err != nil || increment < 0 {
+			return nil, nil, invalid
+		}
+		delaySeconds, err := strconv.Atoi(delay)
+		if err != nil || delaySeconds < 0 {
+			return nil, nil, invalid
+		}
+		if _, exists := timeControls[int8(minutes)]; exists {
+			return nil, nil, fmt.Errorf("duplicate time control for %d minutes", minutes)
+		}
+
+		durations = append(durations, int8(minutes))
+		timeControls[int8(minutes)] = TimeControl{
+			Base:      time.Duration(minutes) * time.Minute,
+			Increment: time.Duration(increment) * time.Second,
+			Delay:     time.Duration(delaySeconds) * time.Second,
+		}
+	}
+	return durations, timeControls, nil
+}
+
+// String returns the time control in the PGN TimeControl tag format, e.g. "180+2"
+func (tc TimeControl) String() string {
+	return fmt.Sprintf("%d+%d", int64(tc.Base/time.Second), int64(tc.Increment/time.Second))
+}
+
 // Getters for private fields
 func (c *Config) GetKafkaBrokers() string {
 	return c.KafkaBrokers
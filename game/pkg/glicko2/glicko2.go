@@ -0,0 +1,123 @@
+// Package glicko2 implements the Glicko-2 rating system as described by Mark Glickman
+// in "Example of the Glicko-2 system" (http://www.glicko.net/glicko/glicko2.pdf)
+package glicko2
+
+import "math"
+
+// values a player without games starts with
+const (
+	DefaultRating     = 1500.0
+	DefaultDeviation  = 350.0
+	DefaultVolatility = 0.06
+)
+
+const (
+	// scale converts ratings between the Glicko and the Glicko-2 scale
+	scale = 173.7178
+	// epsilon is the convergence tolerance of the volatility iteration
+	epsilon = 0.000001
+)
+
+// Rating is a player's rating on the Glicko scale
+type Rating struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
+// Result is the outcome of a single game against an opponent
+type Result struct {
+	Opponent Rating
+	Score    float64 // 1 for a win, 0.5 for a draw and 0 for a loss
+}
+
+// NewRating returns the rating of a player without games
+func NewRating() Rating {
+	return Rating{Rating: DefaultRating, Deviation: DefaultDeviation, Volatility: DefaultVolatility}
+}
+
+// Update returns the player's rating after a rating period with the given results.
+// tau constrains the change in volatility over time, reasonable values are between 0.3 and 1.2
+func Update(player Rating, results []Result, tau float64) Rating {
+	mu := (player.Rating - DefaultRating) / scale
+	phi := player.Deviation / scale
+	sigma := player.Volatility
+
+	// a player who did not play only becomes less certain
+	if len(results) == 0 {
+		return Rating{
+			Rating:     player.Rating,
+			Deviation:  math.Sqrt(phi*phi+sigma*sigma) * scale,
+			Volatility: sigma,
+		}
+	}
+
+	// step 3 and 4: estimated variance and improvement
+	var vInverse, improvement float64
+	for _, result := range results {
+		muJ := (result.Opponent.Rating - DefaultRating) / scale
+		gJ := g(result.Opponent.Deviation / scale)
+		eJ := expected(mu, muJ, gJ)
+		vInverse += gJ * gJ * eJ * (1 - eJ)
+		improvement += gJ * (result.Score - eJ)
+	}
+	v := 1 / vInverse
+	delta := v * improvement
+
+	// step 5: new volatility
+	sigma = volatility(delta, phi, v, sigma, tau)
+
+	// step 6 and 7: new deviation and rating
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu += phi * phi * improvement
+
+	return Rating{
+		Rating:     mu*scale + DefaultRating,
+		Deviation:  phi * scale,
+		Volatility: sigma,
+	}
+}
+
+func g(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func expected(mu, muJ, gJ float64) float64 {
+	return 1 / (1 + math.Exp(-gJ*(mu-muJ)))
+}
+
+// volatility finds the new volatility with the Illinois algorithm
+func volatility(delta, phi, v, sigma, tau float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-d)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		B = a - k*tau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > epsilon {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
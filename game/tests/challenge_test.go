@@ -0,0 +1,97 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestChallengeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		rated bool
+		color genprotos.Color
+	}{
+		{name: "casual with a random colour", color: genprotos.Color_NO_COLOR},
+		{name: "rated as white", rated: true, color: genprotos.Color_WHITE},
+		{name: "rated as black", rated: true, color: genprotos.Color_BLACK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdAt := time.UnixMilli(time.Now().UnixMilli())
+			challenge := &models.Challenge{
+				ID:         "challenge",
+				Challenger: "alice",
+				Challenged: "bob",
+				Duration:   5,
+				Rated:      tt.rated,
+				Color:      tt.color,
+				CreatedAt:  createdAt,
+				ExpiresAt:  createdAt.Add(10 * time.Minute),
+			}
+
+			decoded, err := redisservice.DecodeChallenge(challenge.ID, redisservice.EncodeChallenge(challenge))
+			require.NoError(t, err)
+			assert.Equal(t, challenge, decoded)
+		})
+	}
+}
+
+func TestRestoredChallengeKeepsNewerListed(t *testing.T) {
+	redisService := testRedis(t)
+	challenged := primitive.NewObjectID().Hex()
+	incoming := "player:" + challenged + ":incoming_challenges"
+	now := time.UnixMilli(time.Now().UnixMilli())
+	challenge := func(id string, createdAt time.Time) *models.Challenge {
+		return &models.Challenge{
+			ID:         id,
+			Challenger: "alice",
+			Challenged: challenged,
+			Duration:   5,
+			CreatedAt:  createdAt,
+			ExpiresAt:  createdAt.Add(10 * time.Minute),
+		}
+	}
+	older, newer := challenge("older", now.Add(-8*time.Minute)), challenge("newer", now)
+	t.Cleanup(func() {
+		conn := redisService.Pool.Get()
+		defer conn.Close()
+		conn.Do("DEL", incoming, "challenge:older", "challenge:newer")
+	})
+
+	ttl := func() time.Duration {
+		conn := redisService.Pool.Get()
+		defer conn.Close()
+		ms, err := redis.Int64(conn.Do("PTTL", incoming))
+		require.NoError(t, err)
+		return time.Duration(ms) * time.Millisecond
+	}
+
+	require.NoError(t, redisService.SaveChallenge(older))
+	assert.InDelta(t, 2*time.Minute, ttl(), float64(time.Minute), "a new list expires with its challenge")
+
+	require.NoError(t, redisService.SaveChallenge(newer))
+	// the older challenge is put back after a failed accept
+	taken, err := redisService.TakeChallenge(older)
+	require.NoError(t, err)
+	require.True(t, taken)
+	require.NoError(t, redisService.SaveChallenge(older))
+
+	assert.Greater(t, ttl(), 9*time.Minute, "the list lives as long as the newer challenge")
+	listed, err := redisService.IncomingChallenges(challenged, now)
+	require.NoError(t, err)
+	ids := make([]string, len(listed))
+	for i, challenge := range listed {
+		ids[i] = challenge.ID
+	}
+	assert.Equal(t, []string{"older", "newer"}, ids)
+}
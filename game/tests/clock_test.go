@@ -0,0 +1,85 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ruziba3vich/chess_app/internal/clock"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"github.com/ruziba3vich/chess_app/pkg/config"
+)
+
+// play punches the mover's clock at now and plays the move, the way MakeMove does
+func play(t *testing.T, live *models.LiveGame, move string, now time.Time) {
+	t.Helper()
+	clock.Punch(live, live.Game.Position().Turn(), now)
+	require.NoError(t, live.Game.MoveStr(move))
+}
+
+func TestClockDoesNotRunBeforeBothFirstMoves(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: time.Minute, Increment: 2 * time.Second})
+	start := live.TurnStarted
+
+	_, running := clock.Deadline(live)
+	assert.False(t, running)
+
+	play(t, live, "e2e4", start.Add(20*time.Second))
+	play(t, live, "e7e5", start.Add(50*time.Second))
+
+	assert.Equal(t, time.Minute, live.WhiteClock)
+	assert.Equal(t, time.Minute, live.BlackClock)
+	deadline, running := clock.Deadline(live)
+	assert.True(t, running)
+	assert.Equal(t, start.Add(50*time.Second+time.Minute), deadline)
+}
+
+func TestClockDeductsTimeAndAddsIncrement(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: time.Minute, Increment: 2 * time.Second})
+	start := live.TurnStarted
+	play(t, live, "e2e4", start)
+	play(t, live, "e7e5", start)
+
+	play(t, live, "g1f3", start.Add(10*time.Second))
+	assert.Equal(t, 52*time.Second, live.WhiteClock)
+
+	assert.Equal(t, 55*time.Second, clock.Remaining(live, chess.Black, start.Add(15*time.Second)))
+	assert.Equal(t, 52*time.Second, clock.Remaining(live, chess.White, start.Add(15*time.Second)))
+	assert.Equal(t, time.Duration(0), clock.Remaining(live, chess.Black, start.Add(2*time.Minute)))
+}
+
+func TestClockDelayIsSpentBeforeTheClockRuns(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: time.Minute, Delay: 3 * time.Second})
+	start := live.TurnStarted
+	play(t, live, "e2e4", start)
+	play(t, live, "e7e5", start)
+
+	play(t, live, "g1f3", start.Add(2*time.Second))
+	assert.Equal(t, time.Minute, live.WhiteClock)
+
+	play(t, live, "b8c6", start.Add(7*time.Second))
+	assert.Equal(t, 58*time.Second, live.BlackClock)
+
+	deadline, running := clock.Deadline(live)
+	assert.True(t, running)
+	assert.Equal(t, start.Add(7*time.Second+3*time.Second+time.Minute), deadline)
+}
+
+func TestClockStopFreezesTheRunningClock(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: time.Minute})
+	start := live.TurnStarted
+	play(t, live, "e2e4", start)
+	play(t, live, "e7e5", start)
+
+	clock.Stop(live, start.Add(25*time.Second))
+	live.Status = models.StatusFinished
+
+	assert.Equal(t, 35*time.Second, live.WhiteClock)
+	assert.Equal(t, 35*time.Second, clock.Remaining(live, chess.White, start.Add(time.Hour)))
+	_, running := clock.Deadline(live)
+	assert.False(t, running)
+}
@@ -0,0 +1,99 @@
+package game_service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ruziba3vich/chess_app/internal/game_service"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+)
+
+func TestFirstPlaysWhite(t *testing.T) {
+	const (
+		white = genprotos.Color_WHITE
+		black = genprotos.Color_BLACK
+		none  = genprotos.Color_NO_COLOR
+	)
+
+	tests := []struct {
+		name          string
+		first, second game_service.ColorRequest
+		coin          bool
+		want          bool
+	}{
+		{
+			name: "new players follow the coin",
+			coin: true,
+			want: true,
+		},
+		{
+			name: "new players follow the coin either way",
+			want: false,
+		},
+		{
+			name:   "player who had more whites gets black",
+			first:  game_service.ColorRequest{History: []genprotos.Color{white, white, black}},
+			second: game_service.ColorRequest{History: []genprotos.Color{black}},
+			coin:   true,
+			want:   false,
+		},
+		{
+			name:   "player who had more blacks gets white",
+			first:  game_service.ColorRequest{History: []genprotos.Color{black, black}},
+			second: game_service.ColorRequest{History: []genprotos.Color{white, black}},
+			want:   true,
+		},
+		{
+			name:   "equal balance gives white to whoever had black last",
+			first:  game_service.ColorRequest{History: []genprotos.Color{white, black}},
+			second: game_service.ColorRequest{History: []genprotos.Color{black, white}},
+			coin:   true,
+			want:   false,
+		},
+		{
+			name:   "equal balance against a new player",
+			first:  game_service.ColorRequest{History: []genprotos.Color{black, white}},
+			second: game_service.ColorRequest{},
+			want:   true,
+		},
+		{
+			name:   "preference wins over the balance",
+			first:  game_service.ColorRequest{History: []genprotos.Color{white, white}, Preferred: white},
+			second: game_service.ColorRequest{History: []genprotos.Color{black, black}},
+			want:   true,
+		},
+		{
+			name:   "preference for black of the second player",
+			first:  game_service.ColorRequest{History: []genprotos.Color{white, white}},
+			second: game_service.ColorRequest{Preferred: black},
+			want:   true,
+		},
+		{
+			name:   "matching preferences",
+			first:  game_service.ColorRequest{Preferred: black},
+			second: game_service.ColorRequest{Preferred: white},
+			coin:   true,
+			want:   false,
+		},
+		{
+			name:   "conflicting preferences fall back to the balance",
+			first:  game_service.ColorRequest{History: []genprotos.Color{black}, Preferred: white},
+			second: game_service.ColorRequest{History: []genprotos.Color{none}, Preferred: white},
+			want:   true,
+		},
+		{
+			name:   "conflicting preferences with equal history follow the coin",
+			first:  game_service.ColorRequest{Preferred: black},
+			second: game_service.ColorRequest{Preferred: black},
+			coin:   true,
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, game_service.FirstPlaysWhite(tt.first, tt.second, tt.coin))
+		})
+	}
+}
@@ -0,0 +1,501 @@
+package game_service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+)
+
+func TestMakeMove(t *testing.T) {
+	// white to promote on g8 by taking the knight
+	promotion := []string{"h2h4", "g7g5", "h4g5", "h7h6", "g5h6", "a7a6", "h6h7", "a6a5"}
+
+	tests := []struct {
+		name    string
+		played  []string
+		player  string
+		move    *genprotos.Move
+		err     error
+		success bool
+		message string
+		uci     string
+		san     string
+	}{
+		{
+			name:    "uci",
+			player:  "white",
+			move:    &genprotos.Move{Uci: "e2e4"},
+			success: true,
+			uci:     "e2e4",
+			san:     "e4",
+		},
+		{
+			name:    "uci in upper case",
+			player:  "white",
+			move:    &genprotos.Move{Uci: "G1F3"},
+			success: true,
+			uci:     "g1f3",
+			san:     "Nf3",
+		},
+		{
+			name:    "san",
+			played:  []string{"e2e4"},
+			player:  "black",
+			move:    &genprotos.Move{San: "Nc6"},
+			success: true,
+			uci:     "b8c6",
+			san:     "Nc6",
+		},
+		{
+			name:    "from and to",
+			player:  "white",
+			move:    &genprotos.Move{MoveFrom: "d2", MoveTo: "d4"},
+			success: true,
+			uci:     "d2d4",
+			san:     "d4",
+		},
+		{
+			name:    "uci wins over from and to",
+			player:  "white",
+			move:    &genprotos.Move{Uci: "c2c4", MoveFrom: "d2", MoveTo: "d4"},
+			success: true,
+			uci:     "c2c4",
+			san:     "c4",
+		},
+		{
+			name:    "underpromotion from and to",
+			played:  promotion,
+			player:  "white",
+			move:    &genprotos.Move{MoveFrom: "h7", MoveTo: "g8", Promotion: genprotos.PieceType_KNIGHT.Enum()},
+			success: true,
+			uci:     "h7g8n",
+			san:     "hxg8=N",
+		},
+		{
+			name:    "promotion san",
+			played:  promotion,
+			player:  "white",
+			move:    &genprotos.Move{San: "hxg8=Q"},
+			success: true,
+			uci:     "h7g8q",
+			san:     "hxg8=Q",
+		},
+		{
+			name:    "promotion to a king",
+			played:  promotion,
+			player:  "white",
+			move:    &genprotos.Move{MoveFrom: "h7", MoveTo: "g8", Promotion: genprotos.PieceType_KING.Enum()},
+			message: "h7g8=KING is not a legal move in this position",
+		},
+		{
+			name:    "illegal move",
+			player:  "white",
+			move:    &genprotos.Move{Uci: "e2e5"},
+			message: "e2e5 is not a legal move in this position",
+		},
+		{
+			name:    "unreadable san",
+			player:  "white",
+			move:    &genprotos.Move{San: "Zz9"},
+			message: "Zz9 is not a legal move in this position",
+		},
+		{
+			name:   "empty move",
+			player: "white",
+			move:   &genprotos.Move{},
+		},
+		{
+			name:    "no move",
+			player:  "white",
+			message: "the request does not contain a move",
+		},
+		{
+			name:   "black before white",
+			player: "black",
+			move:   &genprotos.Move{Uci: "e7e5"},
+			err:    storage.ErrNotYourTurn,
+		},
+		{
+			name:   "white twice",
+			played: []string{"e2e4"},
+			player: "white",
+			move:   &genprotos.Move{Uci: "d2d4"},
+			err:    storage.ErrNotYourTurn,
+		},
+		{
+			name:   "spectator",
+			player: "spectator",
+			move:   &genprotos.Move{Uci: "e2e4"},
+			err:    storage.ErrNotParticipant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, redisService := testStorage(t)
+			gameID := startGame(t, redisService, false)
+			playMoves(t, store, redisService, gameID, tt.played...)
+
+			resp, err := store.MakeMove(context.Background(), &genprotos.MakeMoveRequest{
+				GameId:   gameID,
+				PlayerId: tt.player,
+				Move:     tt.move,
+			})
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.success, resp.Success)
+
+			live, err := redisService.GetGame(gameID)
+			require.NoError(t, err)
+			if !tt.success {
+				if tt.message != "" {
+					assert.Equal(t, tt.message, resp.Message)
+				}
+				assert.Len(t, live.Game.Moves(), len(tt.played), "a rejected move is not played")
+				return
+			}
+			assert.Equal(t, tt.uci, resp.Uci)
+			assert.Equal(t, tt.san, resp.San)
+			if assert.Len(t, live.Records, len(tt.played)+1) {
+				record := live.Records[len(tt.played)]
+				assert.Equal(t, tt.uci, record.UCI)
+				assert.Equal(t, tt.san, record.SAN)
+			}
+		})
+	}
+
+	t.Run("unknown game", func(t *testing.T) {
+		store, _ := testStorage(t)
+		_, err := store.MakeMove(context.Background(), &genprotos.MakeMoveRequest{
+			GameId:   "unknown",
+			PlayerId: "white",
+			Move:     &genprotos.Move{Uci: "e2e4"},
+		})
+		assert.ErrorIs(t, err, storage.ErrGameNotFound)
+	})
+}
+
+// action is a step of a game played through the storage, err is the error it is expected to fail with
+type action struct {
+	player string
+	do     string // "move", "offer draw", "decline draw", "take back", "take back move", "accept takeback" or "decline takeback"
+	uci    string // the move of "move"
+	err    error
+}
+
+func act(store *storage.Storage, gameID string, a action) error {
+	ctx := context.Background()
+	var err error
+	switch a.do {
+	case "move":
+		var resp *genprotos.MakeMoveResponse
+		resp, err = store.MakeMove(ctx, &genprotos.MakeMoveRequest{GameId: gameID, PlayerId: a.player, Move: &genprotos.Move{Uci: a.uci}})
+		if err == nil && !resp.Success {
+			err = errors.New(resp.Message)
+		}
+	case "offer draw":
+		_, err = store.OfferDraw(ctx, gameID, a.player)
+	case "decline draw":
+		_, err = store.RespondDraw(ctx, gameID, a.player, false)
+	case "take back", "take back move":
+		_, err = store.RequestTakeback(ctx, gameID, a.player, a.do == "take back move")
+	case "accept takeback", "decline takeback":
+		_, err = store.RespondTakeback(ctx, gameID, a.player, a.do == "accept takeback")
+	default:
+		err = fmt.Errorf("unknown action %q", a.do)
+	}
+	return err
+}
+
+func move(player, uci string) action {
+	return action{player: player, do: "move", uci: uci}
+}
+
+// runActions takes the actions in order and checks that each fails as expected
+func runActions(t *testing.T, store *storage.Storage, gameID string, actions []action) {
+	t.Helper()
+	for i, a := range actions {
+		err := act(store, gameID, a)
+		if a.err != nil {
+			require.ErrorIs(t, err, a.err, "action %d", i)
+		} else {
+			require.NoError(t, err, "action %d", i)
+		}
+	}
+}
+
+func TestDrawOffers(t *testing.T) {
+	opening := []action{move("white", "e2e4"), move("black", "e7e5")}
+
+	tests := []struct {
+		name    string
+		actions []action
+		offer   chess.Color // colour of the open offer after the actions
+	}{
+		{
+			name:    "offer stays open until answered",
+			actions: append(opening, action{player: "white", do: "offer draw"}),
+			offer:   chess.White,
+		},
+		{
+			name: "offer can not be repeated while open",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				action{player: "white", do: "offer draw", err: storage.ErrActionNotAllowed},
+			),
+			offer: chess.White,
+		},
+		{
+			name: "opponent has to respond instead of offering",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				action{player: "black", do: "offer draw", err: storage.ErrActionNotAllowed},
+			),
+			offer: chess.White,
+		},
+		{
+			name: "declined offer can not be repeated before the opponent moves",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				action{player: "black", do: "decline draw"},
+				action{player: "white", do: "offer draw", err: storage.ErrActionNotAllowed},
+				move("white", "g1f3"),
+				action{player: "white", do: "offer draw", err: storage.ErrActionNotAllowed},
+			),
+			offer: chess.NoColor,
+		},
+		{
+			name: "declined offer can be repeated once the opponent moved",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				action{player: "black", do: "decline draw"},
+				move("white", "g1f3"),
+				move("black", "b8c6"),
+				action{player: "white", do: "offer draw"},
+			),
+			offer: chess.White,
+		},
+		{
+			name: "offer survives the offering player's own move",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				move("white", "g1f3"),
+			),
+			offer: chess.White,
+		},
+		{
+			name: "offer lapses once the opponent moved without answering",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				move("white", "g1f3"),
+				move("black", "b8c6"),
+				move("white", "f1c4"),
+			),
+			offer: chess.NoColor,
+		},
+		{
+			name: "only an offer of the opponent can be answered",
+			actions: append(opening,
+				action{player: "white", do: "decline draw", err: storage.ErrActionNotAllowed},
+				action{player: "white", do: "offer draw"},
+				action{player: "white", do: "decline draw", err: storage.ErrActionNotAllowed},
+			),
+			offer: chess.White,
+		},
+		{
+			name:    "spectators can not offer",
+			actions: append(opening, action{player: "spectator", do: "offer draw", err: storage.ErrNotParticipant}),
+			offer:   chess.NoColor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, redisService := testStorage(t)
+			gameID := startGame(t, redisService, false)
+			runActions(t, store, gameID, tt.actions)
+
+			live, err := redisService.GetGame(gameID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.offer, live.DrawOffer)
+		})
+	}
+}
+
+func TestTakebacks(t *testing.T) {
+	opening := []action{move("white", "e2e4"), move("black", "e7e5"), move("white", "g1f3")}
+
+	tests := []struct {
+		name       string
+		disabled   bool
+		actions    []action
+		moves      []string // moves of the game after the actions
+		takenBack  int      // records marked as taken back
+		whiteOffer bool     // whether white may offer a draw after the actions
+	}{
+		{
+			name:       "the last move",
+			actions:    append(opening, action{player: "white", do: "take back"}, action{player: "black", do: "accept takeback"}),
+			moves:      []string{"e2e4", "e7e5"},
+			takenBack:  1,
+			whiteOffer: true,
+		},
+		{
+			name: "the own move together with the reply to it",
+			actions: append(opening,
+				action{player: "black", do: "take back"},
+				action{player: "white", do: "accept takeback"},
+			),
+			moves:      []string{"e2e4"},
+			takenBack:  2,
+			whiteOffer: true,
+		},
+		{
+			name: "a full move whoever played it",
+			actions: append(opening,
+				action{player: "black", do: "take back move"},
+				action{player: "white", do: "accept takeback"},
+			),
+			moves:      []string{"e2e4"},
+			takenBack:  2,
+			whiteOffer: true,
+		},
+		{
+			name: "taken back moves are played again",
+			actions: append(opening,
+				action{player: "white", do: "take back"},
+				action{player: "black", do: "accept takeback"},
+				move("white", "f1c4"),
+			),
+			moves:      []string{"e2e4", "e7e5", "f1c4"},
+			takenBack:  1,
+			whiteOffer: true,
+		},
+		{
+			name: "declined",
+			actions: append(opening,
+				action{player: "white", do: "take back"},
+				action{player: "black", do: "decline takeback"},
+				action{player: "black", do: "accept takeback", err: storage.ErrActionNotAllowed},
+			),
+			moves:      []string{"e2e4", "e7e5", "g1f3"},
+			whiteOffer: true,
+		},
+		{
+			name: "one request at a time",
+			actions: append(opening,
+				action{player: "white", do: "take back"},
+				action{player: "black", do: "take back", err: storage.ErrActionNotAllowed},
+				action{player: "white", do: "accept takeback", err: storage.ErrActionNotAllowed},
+			),
+			moves:      []string{"e2e4", "e7e5", "g1f3"},
+			whiteOffer: true,
+		},
+		{
+			name: "a move ends the request",
+			actions: []action{
+				move("white", "e2e4"),
+				action{player: "white", do: "take back"},
+				move("black", "e7e5"),
+				action{player: "black", do: "accept takeback", err: storage.ErrActionNotAllowed},
+			},
+			moves:      []string{"e2e4", "e7e5"},
+			whiteOffer: true,
+		},
+		{
+			name:       "nothing to take back",
+			actions:    []action{{player: "white", do: "take back", err: storage.ErrActionNotAllowed}},
+			whiteOffer: true,
+		},
+		{
+			name:       "disabled",
+			disabled:   true,
+			actions:    append(opening, action{player: "white", do: "take back", err: storage.ErrActionNotAllowed}),
+			moves:      []string{"e2e4", "e7e5", "g1f3"},
+			whiteOffer: true,
+		},
+		{
+			name: "an open draw offer is withdrawn",
+			actions: append(opening,
+				action{player: "black", do: "offer draw"},
+				action{player: "white", do: "take back"},
+				action{player: "black", do: "accept takeback"},
+				action{player: "white", do: "decline draw", err: storage.ErrActionNotAllowed},
+			),
+			moves:      []string{"e2e4", "e7e5"},
+			takenBack:  1,
+			whiteOffer: true,
+		},
+		{
+			name: "a draw offer made in the taken back moves is forgotten",
+			actions: append(opening,
+				action{player: "white", do: "offer draw"},
+				action{player: "black", do: "decline draw"},
+				action{player: "white", do: "take back"},
+				action{player: "black", do: "accept takeback"},
+			),
+			moves:      []string{"e2e4", "e7e5"},
+			takenBack:  1,
+			whiteOffer: true,
+		},
+		{
+			name: "a draw offer made before the taken back moves is kept",
+			actions: []action{
+				move("white", "e2e4"),
+				move("black", "e7e5"),
+				{player: "white", do: "offer draw"},
+				{player: "black", do: "decline draw"},
+				move("white", "g1f3"),
+				{player: "white", do: "take back"},
+				{player: "black", do: "accept takeback"},
+			},
+			moves:     []string{"e2e4", "e7e5"},
+			takenBack: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, redisService := testStorage(t)
+			gameID := startGame(t, redisService, !tt.disabled)
+			runActions(t, store, gameID, tt.actions)
+
+			live, err := redisService.GetGame(gameID)
+			require.NoError(t, err)
+			moves := make([]string, len(live.Game.Moves()))
+			for i, move := range live.Game.Moves() {
+				moves[i] = chess.UCINotation{}.Encode(live.Game.Positions()[i], move)
+			}
+			assert.Equal(t, len(tt.moves), len(moves))
+			if len(tt.moves) > 0 {
+				assert.Equal(t, tt.moves, moves)
+			}
+			takenBack := 0
+			for _, record := range live.Records {
+				if record.TakenBack {
+					takenBack++
+				}
+			}
+			assert.Equal(t, tt.takenBack, takenBack)
+			assert.Equal(t, len(moves)+tt.takenBack, len(live.Records), "the records of the game's moves are kept in order")
+
+			_, err = store.OfferDraw(context.Background(), gameID, "white")
+			if tt.whiteOffer {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, storage.ErrActionNotAllowed)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+)
+
+func TestGameFilterQuery(t *testing.T) {
+	rated := true
+	since := time.UnixMilli(1700000000000)
+	until := since.Add(24 * time.Hour)
+	after := primitive.NewObjectID()
+	notImported := bson.E{Key: "imported", Value: bson.M{"$ne": true}}
+
+	tests := []struct {
+		name   string
+		filter storage.GameFilter
+		after  primitive.ObjectID
+		want   bson.D
+	}{
+		{
+			name:   "all games of the player",
+			filter: storage.GameFilter{PlayerID: "p1"},
+			want:   bson.D{{Key: "players", Value: "p1"}, notImported},
+		},
+		{
+			name:   "games against an opponent",
+			filter: storage.GameFilter{PlayerID: "p1", OpponentID: "p2"},
+			want:   bson.D{{Key: "players", Value: bson.M{"$all": bson.A{"p1", "p2"}}}, notImported},
+		},
+		{
+			name:   "games with black against an opponent",
+			filter: storage.GameFilter{PlayerID: "p1", OpponentID: "p2", Color: genprotos.Color_BLACK},
+			want:   bson.D{{Key: "black", Value: "p1"}, {Key: "white", Value: "p2"}, notImported},
+		},
+		{
+			name:   "next page of rated blitz games in a date range",
+			filter: storage.GameFilter{PlayerID: "p1", Duration: 5, Rated: &rated, Since: since, Until: until},
+			after:  after,
+			want: bson.D{
+				{Key: "players", Value: "p1"},
+				{Key: "_id", Value: bson.M{"$lt": after}},
+				notImported,
+				{Key: "duration", Value: int8(5)},
+				{Key: "rated", Value: true},
+				{Key: "started_at", Value: bson.M{"$gte": since, "$lt": until}},
+			},
+		},
+		{
+			name:   "won games",
+			filter: storage.GameFilter{PlayerID: "p1", Result: genprotos.PlayerResult_WON},
+			want: bson.D{
+				{Key: "players", Value: "p1"},
+				notImported,
+				{Key: "$or", Value: bson.A{
+					bson.M{"white": "p1", "result": "1-0"},
+					bson.M{"black": "p1", "result": "0-1"},
+				}},
+			},
+		},
+		{
+			name:   "lost games with white",
+			filter: storage.GameFilter{PlayerID: "p1", Color: genprotos.Color_WHITE, Result: genprotos.PlayerResult_LOST},
+			want: bson.D{
+				{Key: "white", Value: "p1"},
+				notImported,
+				{Key: "$or", Value: bson.A{
+					bson.M{"white": "p1", "result": "0-1"},
+					bson.M{"black": "p1", "result": "1-0"},
+				}},
+			},
+		},
+		{
+			name:   "drawn games",
+			filter: storage.GameFilter{PlayerID: "p1", Result: genprotos.PlayerResult_DRAWN},
+			want:   bson.D{{Key: "players", Value: "p1"}, notImported, {Key: "result", Value: "1/2-1/2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Query(tt.after))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package game_service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ruziba3vich/chess_app/pkg/glicko2"
+)
+
+// reference values from the worked example in Glickman's "Example of the Glicko-2 system"
+func TestGlicko2ReferenceExample(t *testing.T) {
+	player := glicko2.Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	results := []glicko2.Result{
+		{Opponent: glicko2.Rating{Rating: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: glicko2.Rating{Rating: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: glicko2.Rating{Rating: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	}
+
+	updated := glicko2.Update(player, results, 0.5)
+
+	assert.InDelta(t, 1464.06, updated.Rating, 0.01)
+	assert.InDelta(t, 151.52, updated.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, updated.Volatility, 0.00001)
+}
+
+func TestGlicko2WithoutGamesOnlyIncreasesDeviation(t *testing.T) {
+	player := glicko2.Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+
+	updated := glicko2.Update(player, nil, 0.5)
+
+	assert.Equal(t, 1500.0, updated.Rating)
+	assert.InDelta(t, 200.27, updated.Deviation, 0.01)
+	assert.Equal(t, 0.06, updated.Volatility)
+}
+
+func TestGlicko2SingleGames(t *testing.T) {
+	tests := []struct {
+		name   string
+		score  float64
+		rating func(t *testing.T, before, after float64)
+	}{
+		{
+			name:  "win raises the rating",
+			score: 1,
+			rating: func(t *testing.T, before, after float64) {
+				assert.Greater(t, after, before)
+			},
+		},
+		{
+			name:  "loss lowers the rating",
+			score: 0,
+			rating: func(t *testing.T, before, after float64) {
+				assert.Less(t, after, before)
+			},
+		},
+		{
+			name:  "draw between equals keeps the rating",
+			score: 0.5,
+			rating: func(t *testing.T, before, after float64) {
+				assert.InDelta(t, before, after, 0.000001)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player := glicko2.NewRating()
+			updated := glicko2.Update(player, []glicko2.Result{{Opponent: glicko2.NewRating(), Score: tt.score}}, 0.5)
+
+			tt.rating(t, player.Rating, updated.Rating)
+			assert.Less(t, updated.Deviation, player.Deviation)
+			// the same inputs always give the same rating
+			assert.Equal(t, updated, glicko2.Update(player, []glicko2.Result{{Opponent: glicko2.NewRating(), Score: tt.score}}, 0.5))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/models"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"github.com/ruziba3vich/chess_app/pkg/config"
+)
+
+func TestLiveGameRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves []string
+	}{
+		{
+			name:  "no moves",
+			moves: nil,
+		},
+		{
+			name:  "kingside castling",
+			moves: []string{"e2e4", "e7e5", "g1f3", "b8c6", "f1c4", "g8f6", "e1g1"},
+		},
+		{
+			name:  "queenside castling",
+			moves: []string{"d2d4", "d7d5", "b1c3", "b8c6", "c1f4", "c8f5", "d1d2", "d8d7", "e1c1", "e8c8"},
+		},
+		{
+			name:  "en passant",
+			moves: []string{"e2e4", "a7a6", "e4e5", "d7d5", "e5d6"},
+		},
+		{
+			name:  "promotion",
+			moves: []string{"h2h4", "g7g5", "h4g5", "h7h6", "g5h6", "a7a6", "h6h7", "a6a5", "h7g8q"},
+		},
+		{
+			name:  "underpromotion with capture",
+			moves: []string{"a2a4", "b7b5", "a4b5", "a7a6", "b5a6", "c8b7", "a6b7", "b8c6", "b7a8n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			live := redisservice.NewLiveGame("white", "black", config.TimeControl{
+				Base:      3 * time.Minute,
+				Increment: 2 * time.Second,
+				Delay:     time.Second,
+			})
+			for _, move := range tt.moves {
+				require.NoError(t, live.Game.MoveStr(move))
+			}
+			live.WhiteClock = 3*time.Minute + 1500*time.Millisecond
+			live.BlackClock = 2 * time.Minute
+			live.Version = 7
+			for _, move := range tt.moves {
+				live.Records = append(live.Records, models.MoveRecord{
+					UCI:      move,
+					PlayedAt: time.UnixMilli(1700000000000),
+					ClockMs:  60000,
+				})
+			}
+
+			fields, err := redisservice.EncodeLiveGame(live)
+			require.NoError(t, err)
+			decoded, err := redisservice.DecodeLiveGame(fields)
+			require.NoError(t, err)
+
+			assert.Equal(t, live.Game.FEN(), decoded.Game.FEN())
+			assert.Equal(t, live.Game.Position().Hash(), decoded.Game.Position().Hash())
+			assert.Equal(t, len(live.Game.Moves()), len(decoded.Game.Moves()))
+			assert.Equal(t, live.StartFEN, decoded.StartFEN)
+			assert.Equal(t, live.White, decoded.White)
+			assert.Equal(t, live.Black, decoded.Black)
+			assert.Equal(t, live.WhiteClock, decoded.WhiteClock)
+			assert.Equal(t, live.BlackClock, decoded.BlackClock)
+			assert.Equal(t, 2*time.Second, decoded.Increment)
+			assert.Equal(t, time.Second, decoded.Delay)
+			assert.Equal(t, live.TurnStarted.UnixMilli(), decoded.TurnStarted.UnixMilli())
+			assert.Equal(t, live.Status, decoded.Status)
+			assert.Equal(t, live.Version, decoded.Version)
+			assert.Equal(t, live.StartedAt.UnixMilli(), decoded.StartedAt.UnixMilli())
+			assert.Equal(t, len(live.Records), len(decoded.Records))
+			for i := range live.Records {
+				assert.Equal(t, live.Records[i].UCI, decoded.Records[i].UCI)
+				assert.True(t, live.Records[i].PlayedAt.Equal(decoded.Records[i].PlayedAt))
+				assert.Equal(t, live.Records[i].ClockMs, decoded.Records[i].ClockMs)
+			}
+			reencoded, err := redisservice.EncodeLiveGame(decoded)
+			require.NoError(t, err)
+			assert.Equal(t, fields, reencoded)
+		})
+	}
+}
+
+func TestLiveGameRoundTripKeepsEnPassantRight(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{})
+	for _, move := range []string{"e2e4", "a7a6", "e4e5", "d7d5"} {
+		require.NoError(t, live.Game.MoveStr(move))
+	}
+
+	decoded := roundTrip(t, live)
+
+	assert.Equal(t, chess.D6, decoded.Game.Position().EnPassantSquare())
+	assert.NoError(t, decoded.Game.MoveStr("e5d6"))
+}
+
+func TestLiveGameRoundTripRestoresResignation(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{})
+	require.NoError(t, live.Game.MoveStr("e2e4"))
+	live.Status = models.StatusFinished
+	live.Outcome = genprotos.GameOutcome_WHITE_WON
+	live.Termination = genprotos.Termination_RESIGNATION
+
+	decoded := roundTrip(t, live)
+
+	assert.Equal(t, chess.WhiteWon, decoded.Game.Outcome())
+	assert.Equal(t, models.StatusFinished, decoded.Status)
+	assert.Equal(t, genprotos.GameOutcome_WHITE_WON, decoded.Outcome)
+	assert.Equal(t, genprotos.Termination_RESIGNATION, decoded.Termination)
+}
+
+func TestLiveGameRoundTripKeepsDrawOffer(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{})
+	decoded := roundTrip(t, live)
+	assert.Equal(t, chess.NoColor, decoded.DrawOffer)
+	assert.Equal(t, -1, decoded.WhiteOffer)
+	assert.Equal(t, -1, decoded.BlackOffer)
+
+	require.NoError(t, live.Game.MoveStr("e2e4"))
+	live.DrawOffer = chess.Black
+	live.BlackOffer = 1
+
+	decoded = roundTrip(t, live)
+
+	assert.Equal(t, chess.Black, decoded.DrawOffer)
+	assert.Equal(t, -1, decoded.WhiteOffer)
+	assert.Equal(t, 1, decoded.BlackOffer)
+}
+
+func TestLiveGameRoundTripKeepsTakebackState(t *testing.T) {
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: 5 * time.Minute})
+	live.Rated = true
+	live.Takebacks = true
+	require.NoError(t, live.Game.MoveStr("e2e4"))
+	live.Records = []models.MoveRecord{
+		{UCI: "d2d4", TakenBack: true},
+		{UCI: "e2e4"},
+	}
+	live.Takeback = chess.White
+	live.TakebackPly = 1
+
+	decoded := roundTrip(t, live)
+
+	assert.Equal(t, 5*time.Minute, decoded.Base)
+	assert.True(t, decoded.Rated)
+	assert.True(t, decoded.Takebacks)
+	assert.Equal(t, chess.White, decoded.Takeback)
+	assert.Equal(t, 1, decoded.TakebackPly)
+	assert.True(t, decoded.Records[0].TakenBack)
+	assert.False(t, decoded.Records[1].TakenBack)
+}
+
+func TestLiveGameFromCustomPosition(t *testing.T) {
+	fen, err := chess.FEN("4k3/P7/8/8/8/8/8/4K3 w - - 0 1")
+	require.NoError(t, err)
+
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{})
+	live.Game = chess.NewGame(fen, chess.UseNotation(chess.UCINotation{}))
+	live.StartFEN = live.Game.FEN()
+	require.NoError(t, live.Game.MoveStr("a7a8r"))
+
+	decoded := roundTrip(t, live)
+
+	assert.Equal(t, "R3k3/8/8/8/8/8/8/4K3 b - - 0 1", decoded.Game.FEN())
+}
+
+func roundTrip(t *testing.T, live *models.LiveGame) *models.LiveGame {
+	t.Helper()
+	fields, err := redisservice.EncodeLiveGame(live)
+	require.NoError(t, err)
+	decoded, err := redisservice.DecodeLiveGame(fields)
+	require.NoError(t, err)
+	return decoded
+}
@@ -0,0 +1,82 @@
+package game_service_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	redisservice "github.com/ruziba3vich/chess_app/internal/redis_service"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+	"github.com/ruziba3vich/chess_app/pkg/config"
+)
+
+const testAbandonGrace = 30 * time.Second
+
+// testRedis connects to the Redis the tests run against, the test is skipped when there is none
+func testRedis(t *testing.T) *redisservice.RedisStorage {
+	t.Helper()
+	pool := redisservice.NewPool("localhost:6379")
+	conn := pool.Get()
+	_, err := redis.String(conn.Do("PING"))
+	conn.Close()
+	if err != nil {
+		pool.Close()
+		t.Skip("redis is not available:", err)
+	}
+	t.Cleanup(func() { pool.Close() })
+	return redisservice.NewRedisStorage(pool, "test:"+primitive.NewObjectID().Hex())
+}
+
+// testStorage returns a storage of live games on the test Redis. It has no MongoDB,
+// so actions that end a game can not be run on it
+func testStorage(t *testing.T) (*storage.Storage, *redisservice.RedisStorage) {
+	t.Helper()
+	redisService := testRedis(t)
+	cfg := &config.Config{GameConfig: &config.GameConfig{
+		AbandonGrace:   testAbandonGrace,
+		AbandonForfeit: 2 * testAbandonGrace,
+	}}
+	return storage.NewStorage(nil, log.New(io.Discard, "", 0), redisService, cfg), redisService
+}
+
+// startGame seeds a live five minute game of the players "white" and "black"
+func startGame(t *testing.T, redisService *redisservice.RedisStorage, takebacks bool) string {
+	t.Helper()
+	gameID := primitive.NewObjectID().Hex()
+	live := redisservice.NewLiveGame("white", "black", config.TimeControl{Base: 5 * time.Minute})
+	live.Takebacks = takebacks
+	require.NoError(t, redisService.SaveGame(gameID, live))
+	require.NoError(t, redisService.TrackPresence(gameID, []string{"white", "black"}, live.StartedAt))
+	t.Cleanup(func() {
+		redisService.ForgetPresence(gameID, []string{"white", "black"})
+		redisService.ExpireGame(gameID, time.Millisecond)
+	})
+	return gameID
+}
+
+// playMoves plays the UCI moves through MakeMove, each by the player whose turn it is
+func playMoves(t *testing.T, store *storage.Storage, redisService *redisservice.RedisStorage, gameID string, moves ...string) {
+	t.Helper()
+	for _, move := range moves {
+		live, err := redisService.GetGame(gameID)
+		require.NoError(t, err)
+		player := live.White
+		if len(live.Game.Moves())%2 == 1 {
+			player = live.Black
+		}
+		resp, err := store.MakeMove(context.Background(), &genprotos.MakeMoveRequest{
+			GameId:   gameID,
+			PlayerId: player,
+			Move:     &genprotos.Move{Uci: move},
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Success, resp.Message)
+	}
+}
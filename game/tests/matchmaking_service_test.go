@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ruziba3vich/chess_app/internal/game_service"
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
 	"github.com/ruziba3vich/chess_app/internal/storage"
 	"github.com/ruziba3vich/chess_app/pkg/config"
 )
@@ -22,8 +23,8 @@ type MockStorage struct {
 	storage.Storage
 }
 
-func (m *MockStorage) CreateGameStorage(ctx context.Context, player1, player2 string, duration int8) (string, error) {
-	args := m.Called(ctx, player1, player2, duration)
+func (m *MockStorage) CreateGameStorage(ctx context.Context, player1, player2 string, settings storage.GameSettings) (string, error) {
+	args := m.Called(ctx, player1, player2, settings)
 	return args.Get(0).(string), args.Error(1)
 }
 
@@ -35,7 +36,7 @@ func TestMatchmakingService(t *testing.T) {
 	mockStorage := new(MockStorage)
 	config, _ := config.LoadConfig()
 	logger := log.New(os.Stdout, "", log.LstdFlags)
-	playerChannels := make(map[string]chan string)
+	playerChannels := make(map[string]chan *genprotos.MatchEvent)
 	wg := &sync.WaitGroup{}
 
 	// Read Lua script from file
@@ -55,37 +56,38 @@ func TestMatchmakingService(t *testing.T) {
 	-- Remove players from the queue
 	redis.call('ZREM', key, p1, p2)
 	
-	-- Return matched players
-	return {p1, p2}
+	-- Return matched players with their scores and join times
+	return {p1, p2, '0', '0', '0', '0'}
 	`
 	logger.Println(luaScript)
 
-	service := game_service.NewMatchmakingService(redisClient, playerChannels, config, storage.NewStorage(nil, logger, nil), wg, logger, luaScript)
+	service := game_service.NewMatchmakingService(redisClient, playerChannels, config, storage.NewStorage(nil, logger, nil, config), wg, logger, luaScript)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	go service.MatchPlayers(ctx, 10, 50, 10)
+	go service.RunDelivery(ctx)
+	go service.MatchPlayers(ctx, game_service.Queue{Duration: 10})
 	// Create player channels
-	ch1 := make(chan string, 1)
-	ch2 := make(chan string, 1)
+	ch1 := make(chan *genprotos.MatchEvent, 1)
+	ch2 := make(chan *genprotos.MatchEvent, 1)
 
 	// Add players
-	service.AddPlayer(context.Background(), "player1", 1500, 10, ch1)
-	service.AddPlayer(context.Background(), "player2", 1520, 10, ch2)
+	service.AddPlayer(context.Background(), "player1", 1500, game_service.Queue{Duration: 10}, genprotos.Color_NO_COLOR, ch1)
+	service.AddPlayer(context.Background(), "player2", 1520, game_service.Queue{Duration: 10}, genprotos.Color_NO_COLOR, ch2)
 
 	// Run matchmaking in a goroutine
 
 	// Validate match result
 	select {
-	case gameID := <-ch1:
-		assert.Equal(t, "game123", gameID)
+	case event := <-ch1:
+		assert.Equal(t, "game123", event.GetMatched().GetGameId())
 	case <-time.After(2 * time.Second):
 		t.Fatal("Player 1 did not receive a match")
 	}
 
 	select {
-	case gameID := <-ch2:
-		assert.Equal(t, "game123", gameID)
+	case event := <-ch2:
+		assert.Equal(t, "game123", event.GetMatched().GetGameId())
 	case <-time.After(2 * time.Second):
 		t.Fatal("Player 2 did not receive a match")
 	}
@@ -0,0 +1,208 @@
+package game_service_test
+
+import (
+	"testing"
+
+	"github.com/notnil/chess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+)
+
+func TestEvaluateOutcome(t *testing.T) {
+	tests := []struct {
+		name        string
+		fen         string // empty for the standard starting position
+		moves       []string
+		check       bool
+		outcome     genprotos.GameOutcome
+		termination genprotos.Termination
+	}{
+		{
+			name:        "opening move",
+			moves:       []string{"e2e4"},
+			outcome:     genprotos.GameOutcome_ONGOING,
+			termination: genprotos.Termination_NO_TERMINATION,
+		},
+		{
+			name:        "check that is not mate",
+			moves:       []string{"e2e4", "f7f6", "d1h5"},
+			check:       true,
+			outcome:     genprotos.GameOutcome_ONGOING,
+			termination: genprotos.Termination_NO_TERMINATION,
+		},
+		{
+			name:        "fool's mate",
+			moves:       []string{"f2f3", "e7e5", "g2g4", "d8h4"},
+			check:       true,
+			outcome:     genprotos.GameOutcome_BLACK_WON,
+			termination: genprotos.Termination_CHECKMATE,
+		},
+		{
+			name:        "scholar's mate",
+			moves:       []string{"e2e4", "e7e5", "f1c4", "b8c6", "d1h5", "g8f6", "h5f7"},
+			check:       true,
+			outcome:     genprotos.GameOutcome_WHITE_WON,
+			termination: genprotos.Termination_CHECKMATE,
+		},
+		{
+			name:        "back rank mate",
+			fen:         "6k1/5ppp/8/8/8/8/8/R5K1 w - - 0 1",
+			moves:       []string{"a1a8"},
+			check:       true,
+			outcome:     genprotos.GameOutcome_WHITE_WON,
+			termination: genprotos.Termination_CHECKMATE,
+		},
+		{
+			name:        "stalemate",
+			fen:         "7k/8/6K1/8/8/8/8/5Q2 w - - 0 1",
+			moves:       []string{"f1f7"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_STALEMATE,
+		},
+		{
+			name:        "threefold repetition",
+			moves:       []string{"g1f3", "g8f6", "f3g1", "f6g8", "g1f3", "g8f6", "f3g1", "f6g8"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_THREEFOLD_REPETITION,
+		},
+		{
+			name:        "position repeated twice",
+			moves:       []string{"g1f3", "g8f6", "f3g1", "f6g8"},
+			outcome:     genprotos.GameOutcome_ONGOING,
+			termination: genprotos.Termination_NO_TERMINATION,
+		},
+		{
+			name:        "fifty-move rule",
+			fen:         "8/8/8/4k3/8/8/4K3/R7 w - - 99 80",
+			moves:       []string{"a1a2"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_FIFTY_MOVE_RULE,
+		},
+		{
+			name:        "pawn move resets the fifty-move count",
+			fen:         "8/8/8/4k3/8/8/P3K3/R7 w - - 99 80",
+			moves:       []string{"a2a3"},
+			outcome:     genprotos.GameOutcome_ONGOING,
+			termination: genprotos.Termination_NO_TERMINATION,
+		},
+		{
+			name:        "seventy-five-move rule",
+			fen:         "8/8/8/4k3/8/8/4K3/R7 w - - 149 80",
+			moves:       []string{"a1a2"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_SEVENTY_FIVE_MOVE_RULE,
+		},
+		{
+			name:        "insufficient material after capture",
+			fen:         "8/8/8/4k3/8/8/3pK3/8 w - - 0 1",
+			moves:       []string{"e2d2"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_INSUFFICIENT_MATERIAL,
+		},
+		{
+			name:        "king and bishop against king",
+			fen:         "8/8/8/4k3/8/8/3rK3/5B2 w - - 0 1",
+			moves:       []string{"e2d2"},
+			outcome:     genprotos.GameOutcome_DRAW,
+			termination: genprotos.Termination_INSUFFICIENT_MATERIAL,
+		},
+		{
+			name:        "king and rook against king is sufficient",
+			fen:         "8/8/8/4k3/8/8/3pK3/R7 w - - 0 1",
+			moves:       []string{"e2d2"},
+			outcome:     genprotos.GameOutcome_ONGOING,
+			termination: genprotos.Termination_NO_TERMINATION,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := []func(*chess.Game){chess.UseNotation(chess.UCINotation{})}
+			if tt.fen != "" {
+				fen, err := chess.FEN(tt.fen)
+				require.NoError(t, err)
+				options = append(options, fen)
+			}
+			game := chess.NewGame(options...)
+			for _, move := range tt.moves {
+				require.NoError(t, game.MoveStr(move))
+			}
+
+			outcome, termination := storage.EvaluateOutcome(game)
+			assert.Equal(t, tt.outcome, outcome)
+			assert.Equal(t, tt.termination, termination)
+			assert.Equal(t, tt.check, storage.IsCheck(game))
+		})
+	}
+}
+
+func TestTimeoutOutcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		fen     string
+		flagged chess.Color
+		outcome genprotos.GameOutcome
+	}{
+		{
+			name:    "starting position",
+			fen:     "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+			flagged: chess.White,
+			outcome: genprotos.GameOutcome_BLACK_WON,
+		},
+		{
+			name:    "bare king of the opponent",
+			fen:     "4k3/8/8/8/8/8/4P3/4K3 w - - 0 1",
+			flagged: chess.White,
+			outcome: genprotos.GameOutcome_DRAW,
+		},
+		{
+			name:    "lone knight against a bare king",
+			fen:     "4k3/8/8/8/8/8/8/1N2K3 b - - 0 1",
+			flagged: chess.Black,
+			outcome: genprotos.GameOutcome_DRAW,
+		},
+		{
+			name:    "lone bishop against a bare king",
+			fen:     "4k3/8/2b5/8/8/8/8/4K3 w - - 0 1",
+			flagged: chess.White,
+			outcome: genprotos.GameOutcome_DRAW,
+		},
+		{
+			name:    "lone knight against a king and pawn",
+			fen:     "4k3/4p3/8/8/8/8/8/1N2K3 b - - 0 1",
+			flagged: chess.Black,
+			outcome: genprotos.GameOutcome_WHITE_WON,
+		},
+		{
+			name:    "two knights",
+			fen:     "4k3/8/8/8/8/8/8/1N2K1N1 b - - 0 1",
+			flagged: chess.Black,
+			outcome: genprotos.GameOutcome_WHITE_WON,
+		},
+		{
+			name:    "lone rook",
+			fen:     "4k3/8/8/8/8/8/8/R3K3 b - - 0 1",
+			flagged: chess.Black,
+			outcome: genprotos.GameOutcome_WHITE_WON,
+		},
+		{
+			name:    "lone pawn",
+			fen:     "4k3/8/8/8/8/8/3p4/4K3 w - - 0 1",
+			flagged: chess.White,
+			outcome: genprotos.GameOutcome_BLACK_WON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fen, err := chess.FEN(tt.fen)
+			require.NoError(t, err)
+			game := chess.NewGame(fen)
+
+			assert.Equal(t, tt.outcome, storage.TimeoutOutcome(game.Position(), tt.flagged))
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+)
+
+func TestRenderPGN(t *testing.T) {
+	game := &models.GameModel{
+		White:       "alice",
+		Black:       "bob",
+		Result:      "0-1",
+		Termination: "CHECKMATE",
+		StartedAt:   time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		Moves: []models.MoveRecord{
+			{UCI: "f2f3", SAN: "f3"},
+			{UCI: "e7e5", SAN: "e5"},
+			{UCI: "g2g4", SAN: "g4"},
+			{UCI: "d8h4", SAN: "Qh4#"},
+		},
+	}
+
+	expected := `[Event "Online game"]
+[Site "chess_app"]
+[Date "2025.03.14"]
+[Round "-"]
+[White "alice"]
+[Black "bob"]
+[Result "0-1"]
+[Termination "CHECKMATE"]
+
+1. f3 e5 2. g4 Qh4# 0-1
+`
+	assert.Equal(t, expected, storage.RenderPGN(game))
+}
+
+func TestRenderPGNFromPositionWithBlackToMove(t *testing.T) {
+	game := &models.GameModel{
+		White:     "alice",
+		Black:     "bob",
+		StartFEN:  "4k3/8/8/8/8/8/p7/4K3 b - - 0 40",
+		StartedAt: time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		Moves: []models.MoveRecord{
+			{UCI: "a2a1q", SAN: "a1=Q+"},
+			{UCI: "e1e2", SAN: "Ke2"},
+		},
+	}
+
+	expected := `[Event "Online game"]
+[Site "chess_app"]
+[Date "2025.03.14"]
+[Round "-"]
+[White "alice"]
+[Black "bob"]
+[Result "*"]
+[SetUp "1"]
+[FEN "4k3/8/8/8/8/8/p7/4K3 b - - 0 40"]
+
+40... a1=Q+ 41. Ke2 *
+`
+	assert.Equal(t, expected, storage.RenderPGN(game))
+}
+
+func TestRenderPGNSkipsTakenBackMoves(t *testing.T) {
+	game := &models.GameModel{
+		White:     "alice",
+		Black:     "bob",
+		StartedAt: time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		Moves: []models.MoveRecord{
+			{UCI: "e2e4", SAN: "e4"},
+			{UCI: "e7e5", SAN: "e5"},
+			{UCI: "d1h5", SAN: "Qh5", TakenBack: true},
+			{UCI: "g1f3", SAN: "Nf3"},
+			{UCI: "b8c6", SAN: "Nc6"},
+		},
+	}
+
+	expected := `[Event "Online game"]
+[Site "chess_app"]
+[Date "2025.03.14"]
+[Round "-"]
+[White "alice"]
+[Black "bob"]
+[Result "*"]
+
+1. e4 e5 2. Nf3 Nc6 *
+`
+	assert.Equal(t, expected, storage.RenderPGN(game))
+}
+
+func TestRenderPGNWithRatingsAndClocks(t *testing.T) {
+	game := &models.GameModel{
+		White:           "alice",
+		Black:           "bob",
+		Result:          "1-0",
+		Termination:     "RESIGNATION",
+		TimeControl:     "180+2",
+		StartedAt:       time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		WhiteRating:     1512,
+		BlackRating:     1498,
+		WhiteRatingDiff: 6,
+		BlackRatingDiff: -6,
+		RatingsApplied:  true,
+		Moves: []models.MoveRecord{
+			{UCI: "e2e4", SAN: "e4", ClockMs: 180_000},
+			{UCI: "e7e5", SAN: "e5", ClockMs: 179_400},
+			{UCI: "g1f3", SAN: "Nf3", ClockMs: 3_725_900},
+		},
+	}
+
+	expected := `[Event "Online game"]
+[Site "chess_app"]
+[Date "2025.03.14"]
+[Round "-"]
+[White "alice"]
+[Black "bob"]
+[Result "1-0"]
+[WhiteElo "1512"]
+[BlackElo "1498"]
+[WhiteRatingDiff "+6"]
+[BlackRatingDiff "-6"]
+[TimeControl "180+2"]
+[Termination "RESIGNATION"]
+
+1. e4 { [%clk 0:03:00] } 1... e5 { [%clk 0:02:59] } 2. Nf3 { [%clk 1:02:05] }
+1-0
+`
+	assert.Equal(t, expected, storage.RenderPGN(game))
+}
+
+func TestParsePGN(t *testing.T) {
+	pgn := `[Event "Club championship"]
+[Site "Tashkent"]
+[Date "2024.11.02"]
+[Round "3"]
+[White "Alice"]
+[Black "Bob"]
+[Result "0-1"]
+[WhiteElo "2010"]
+[TimeControl "300+3"]
+[Annotator "coach"]
+
+1. f3 { [%clk 0:05:02] } 1... e5 { [%clk 0:05:01] } 2. g4 { [%clk 0:04:58.5] }
+2... Qh4# { [%clk 0:05:00] } 0-1
+
+[Event "Casual"]
+[White "Carol"]
+[Black "Dave"]
+[Result "1/2-1/2"]
+[FEN "4k3/8/8/8/8/8/p7/4K3 b - - 0 40"]
+[SetUp "1"]
+
+40... a1=Q+ 41. Ke2 1/2-1/2
+`
+	now := time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC)
+
+	games, err := storage.ParsePGN(pgn, "importer", now)
+	assert.NoError(t, err)
+	if !assert.Len(t, games, 2) {
+		return
+	}
+
+	first := games[0]
+	assert.True(t, first.Imported)
+	assert.Equal(t, "importer", first.ImportedBy)
+	assert.Equal(t, []string{"Alice", "Bob"}, first.Players)
+	assert.Equal(t, "0-1", first.Result)
+	assert.Equal(t, "CHECKMATE", first.Termination)
+	assert.Equal(t, time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC), first.StartedAt)
+	assert.Equal(t, 2010, first.WhiteRating)
+	assert.Equal(t, int8(5), first.Duration)
+	assert.Equal(t, int64(3000), first.IncrementMs)
+	assert.Equal(t, map[string]string{"Event": "Club championship", "Site": "Tashkent", "Round": "3", "Annotator": "coach"}, first.Tags)
+	assert.Equal(t, []models.MoveRecord{
+		{UCI: "f2f3", SAN: "f3", ClockMs: 302_000},
+		{UCI: "e7e5", SAN: "e5", ClockMs: 301_000},
+		{UCI: "g2g4", SAN: "g4", ClockMs: 298_500},
+		{UCI: "d8h4", SAN: "Qh4#", ClockMs: 300_000},
+	}, first.Moves)
+	assert.Equal(t, int64(298_500), first.WhiteClock)
+	assert.Equal(t, int64(300_000), first.BlackClock)
+
+	second := games[1]
+	assert.Equal(t, "4k3/8/8/8/8/8/p7/4K3 b - - 0 40", second.StartFEN)
+	assert.Equal(t, "1/2-1/2", second.Result)
+	assert.Equal(t, now, second.StartedAt)
+	assert.Equal(t, []string{"a2a1q", "e1e2"}, []string{second.Moves[0].UCI, second.Moves[1].UCI})
+}
+
+func TestParsePGNRejectsInvalidGames(t *testing.T) {
+	tests := []struct {
+		name string
+		pgn  string
+	}{
+		{
+			name: "no games",
+			pgn:  "just some text",
+		},
+		{
+			name: "illegal move",
+			pgn:  "[White \"Alice\"]\n[Black \"Bob\"]\n\n1. e4 e5 2. Ke3 *\n",
+		},
+		{
+			name: "result contradicting the checkmate",
+			pgn:  "[White \"Alice\"]\n[Black \"Bob\"]\n\n1. f3 e5 2. g4 Qh4# 1-0\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := storage.ParsePGN(tt.pgn, "importer", time.Now())
+			assert.ErrorIs(t, err, storage.ErrInvalidPGN)
+		})
+	}
+}
+
+func TestRenderPGNKeepsImportedTags(t *testing.T) {
+	pgn := `[Event "Club championship"]
+[Site "Tashkent"]
+[Date "2024.11.02"]
+[Round "3"]
+[White "Alice"]
+[Black "Bob"]
+[Result "1-0"]
+[ECO "C20"]
+[Opening "King's pawn game"]
+[Termination "Normal"]
+
+1. e4 e5 1-0
+`
+	games, err := storage.ParsePGN(pgn, "importer", time.Now())
+	assert.NoError(t, err)
+	if !assert.Len(t, games, 1) {
+		return
+	}
+
+	expected := `[Event "Club championship"]
+[Site "Tashkent"]
+[Date "2024.11.02"]
+[Round "3"]
+[White "Alice"]
+[Black "Bob"]
+[Result "1-0"]
+[ECO "C20"]
+[Opening "King's pawn game"]
+[Termination "Normal"]
+
+1. e4 e5 1-0
+`
+	assert.Equal(t, expected, storage.RenderPGN(games[0]))
+}
+
+func TestPGNTagEscapesRoundTrip(t *testing.T) {
+	pgn := `[Event "Club \"open\" championship"]
+[Site "Tashkent"]
+[Date "2024.11.02"]
+[Round "3"]
+[White "Alice"]
+[Black "Bob\\Robert"]
+[Result "1-0"]
+[Annotator "C:\\Games\\"]
+
+1. e4 e5 1-0
+`
+	games, err := storage.ParsePGN(pgn, "importer", time.Now())
+	assert.NoError(t, err)
+	if !assert.Len(t, games, 1) {
+		return
+	}
+
+	assert.Equal(t, `Bob\Robert`, games[0].Black)
+	assert.Equal(t, map[string]string{"Event": `Club "open" championship`, "Site": "Tashkent", "Round": "3", "Annotator": `C:\Games\`}, games[0].Tags)
+	assert.Equal(t, pgn, storage.RenderPGN(games[0]))
+}
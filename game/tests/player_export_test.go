@@ -0,0 +1,61 @@
+package game_service_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ruziba3vich/chess_app/internal/models"
+	"github.com/ruziba3vich/chess_app/internal/storage"
+)
+
+func TestRenderJSON(t *testing.T) {
+	id := primitive.NewObjectID()
+	game := &models.GameModel{
+		ID:          id,
+		White:       "alice",
+		Black:       "bob",
+		Duration:    3,
+		TimeControl: "180+2",
+		Rated:       true,
+		Status:      models.StatusFinished,
+		Result:      "1-0",
+		Termination: "RESIGNATION",
+		StartedAt:   time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		EndedAt:     time.Date(2025, 3, 14, 18, 36, 0, 0, time.UTC),
+		Moves: []models.MoveRecord{
+			{UCI: "e2e4", SAN: "e4", ClockMs: 180_000},
+			{UCI: "e7e5", SAN: "e5", ClockMs: 179_000, TakenBack: true},
+			{UCI: "c7c5", SAN: "c5", ClockMs: 178_000},
+		},
+	}
+
+	line, err := storage.RenderJSON(game)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(line, "\n"))
+	assert.Equal(t, 1, strings.Count(line, "\n"), "one game per line")
+
+	var decoded struct {
+		ID     string `json:"id"`
+		White  string `json:"white"`
+		Result string `json:"result"`
+		Moves  []struct {
+			SAN     string `json:"san"`
+			ClockMs int64  `json:"clock_ms"`
+		} `json:"moves"`
+		StartedAt time.Time `json:"started_at"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(line), &decoded))
+	assert.Equal(t, id.Hex(), decoded.ID)
+	assert.Equal(t, "alice", decoded.White)
+	assert.Equal(t, "1-0", decoded.Result)
+	assert.Equal(t, game.StartedAt, decoded.StartedAt)
+	if assert.Len(t, decoded.Moves, 2, "taken back moves are left out") {
+		assert.Equal(t, "c5", decoded.Moves[1].SAN)
+		assert.Equal(t, int64(178_000), decoded.Moves[1].ClockMs)
+	}
+}
@@ -0,0 +1,38 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovingKeepsPlayerPresent(t *testing.T) {
+	store, redisService := testStorage(t)
+	gameID := startGame(t, redisService, false)
+	// both players were last seen long before the forfeit time, e.g. because they never opened a stream
+	require.NoError(t, redisService.TrackPresence(gameID, []string{"white", "black"}, time.Now().Add(-time.Hour)))
+
+	absent := func() []string {
+		pairs, err := redisService.AbsentPlayers(time.Now().Add(-testAbandonGrace), 1000)
+		require.NoError(t, err)
+		var players []string
+		for _, pair := range pairs {
+			if pair[0] == gameID {
+				players = append(players, pair[1])
+			}
+		}
+		return players
+	}
+
+	for i, move := range []string{"e2e4", "e7e5", "g1f3", "b8c6", "f1c4"} {
+		playMoves(t, store, redisService, gameID, move)
+
+		if i == 0 {
+			assert.Equal(t, []string{"black"}, absent(), "only the player who has not moved yet is away")
+		} else {
+			assert.Empty(t, absent(), "players who keep moving are never away")
+		}
+	}
+}
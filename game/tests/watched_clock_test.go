@@ -0,0 +1,134 @@
+package game_service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ruziba3vich/chess_app/internal/genprotos"
+	"github.com/ruziba3vich/chess_app/internal/service"
+)
+
+func moveMade(version int64, white bool, whiteMs, blackMs int64) *genprotos.GameEvent {
+	return &genprotos.GameEvent{Event: &genprotos.GameEvent_MoveMade{MoveMade: &genprotos.MoveMade{
+		Move:         &genprotos.Move{IsWhite: white},
+		WhiteClockMs: whiteMs,
+		BlackClockMs: blackMs,
+		Version:      version,
+	}}}
+}
+
+func TestWatchedClock(t *testing.T) {
+	// two moves into the game with white to move, taken at version 5
+	snapshot := &genprotos.GameSnapshot{
+		Moves:        []string{"e4", "e5"},
+		WhiteClockMs: 60000,
+		BlackClockMs: 50000,
+		Turn:         genprotos.Color_WHITE,
+		Version:      5,
+	}
+
+	tests := []struct {
+		name     string
+		snapshot *genprotos.GameSnapshot
+		events   []*genprotos.GameEvent
+		applied  []bool
+		elapsed  time.Duration // between the last event and the tick
+		want     *genprotos.ClockTick
+	}{
+		{
+			name:     "the clock of the side to move of the snapshot runs",
+			snapshot: snapshot,
+			elapsed:  3 * time.Second,
+			want:     &genprotos.ClockTick{WhiteClockMs: 57000, BlackClockMs: 50000},
+		},
+		{
+			name:     "moves the snapshot has are left out",
+			snapshot: snapshot,
+			events:   []*genprotos.GameEvent{moveMade(4, false, 60000, 50000), moveMade(5, false, 60000, 50000)},
+			applied:  []bool{false, false},
+			elapsed:  3 * time.Second, // 5 seconds after the snapshot
+			want:     &genprotos.ClockTick{WhiteClockMs: 55000, BlackClockMs: 50000},
+		},
+		{
+			name:     "a move after the snapshot starts the clock of the opponent",
+			snapshot: snapshot,
+			events:   []*genprotos.GameEvent{moveMade(5, false, 60000, 50000), moveMade(6, true, 58000, 50000)},
+			applied:  []bool{false, true},
+			elapsed:  2 * time.Second,
+			want:     &genprotos.ClockTick{WhiteClockMs: 58000, BlackClockMs: 48000},
+		},
+		{
+			name:     "a move delivered twice is applied once",
+			snapshot: snapshot,
+			events: []*genprotos.GameEvent{
+				moveMade(6, true, 58000, 50000),
+				moveMade(6, true, 58000, 50000),
+				moveMade(7, false, 58000, 49000),
+			},
+			applied: []bool{true, false, true},
+			elapsed: time.Second,
+			want:    &genprotos.ClockTick{WhiteClockMs: 57000, BlackClockMs: 49000},
+		},
+		{
+			name:     "a takeback gives the move back to the side to move of its position",
+			snapshot: snapshot,
+			events: []*genprotos.GameEvent{
+				moveMade(6, true, 58000, 50000),
+				{Event: &genprotos.GameEvent_TakenBack{TakenBack: &genprotos.TakenBack{
+					Plies:        1,
+					Fen:          "rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2",
+					WhiteClockMs: 60000,
+					BlackClockMs: 50000,
+					Version:      7,
+				}}},
+			},
+			applied: []bool{true, true},
+			elapsed: 4 * time.Second,
+			want:    &genprotos.ClockTick{WhiteClockMs: 56000, BlackClockMs: 50000},
+		},
+		{
+			name:     "the delay is spent before the clock runs",
+			snapshot: &genprotos.GameSnapshot{Moves: []string{"e4", "e5"}, WhiteClockMs: 60000, BlackClockMs: 50000, DelayMs: 2000, Version: 5},
+			events:   []*genprotos.GameEvent{moveMade(6, true, 60000, 50000)},
+			applied:  []bool{true},
+			elapsed:  5 * time.Second,
+			want:     &genprotos.ClockTick{WhiteClockMs: 60000, BlackClockMs: 47000},
+		},
+		{
+			name:     "clocks do not run before both players have moved",
+			snapshot: &genprotos.GameSnapshot{WhiteClockMs: 60000, BlackClockMs: 60000, Version: 1},
+			events:   []*genprotos.GameEvent{moveMade(2, true, 60000, 60000)},
+			applied:  []bool{true},
+			elapsed:  5 * time.Second,
+		},
+		{
+			name:     "clocks stop with the result",
+			snapshot: snapshot,
+			events: []*genprotos.GameEvent{
+				{Event: &genprotos.GameEvent_Result{Result: &genprotos.GameResult{}}},
+			},
+			applied: []bool{true},
+			elapsed: 5 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.UnixMilli(1700000000000)
+			clocks := service.NewWatchedClock(tt.snapshot, now)
+			for i, event := range tt.events {
+				now = now.Add(time.Second)
+				assert.Equal(t, tt.applied[i], clocks.Apply(event, now), "event %d", i)
+			}
+
+			tick, running := clocks.Tick(now.Add(tt.elapsed))
+			assert.Equal(t, tt.want != nil, running)
+			if tt.want != nil {
+				assert.Equal(t, tt.want.WhiteClockMs, tick.WhiteClockMs)
+				assert.Equal(t, tt.want.BlackClockMs, tick.BlackClockMs)
+			}
+		})
+	}
+}